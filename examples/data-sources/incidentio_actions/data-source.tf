@@ -0,0 +1,18 @@
+data "incidentio_actions" "follow_ups" {
+  is_follow_up  = true
+  incident_mode = "real"
+}
+
+locals {
+  outstanding_follow_ups = [
+    for action in data.incidentio_actions.follow_ups.actions :
+    action if action.status == "outstanding"
+  ]
+}
+
+output "outstanding_follow_ups" {
+  value = {
+    for action in local.outstanding_follow_ups :
+    action.id => try(action.external_issue_reference.issue_permalink, action.description)
+  }
+}
@@ -0,0 +1,7 @@
+data "incidentio_custom_field" "affected_team" {
+  name = "Affected Team"
+}
+
+output "payments_team_option_id" {
+  value = data.incidentio_custom_field.affected_team.option_ids["Payments"]
+}
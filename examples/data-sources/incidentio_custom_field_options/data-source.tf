@@ -0,0 +1,11 @@
+data "incidentio_custom_field" "affected_service" {
+  name = "Affected Service"
+}
+
+data "incidentio_custom_field_options" "affected_service" {
+  custom_field_id = data.incidentio_custom_field.affected_service.id
+}
+
+output "payments_option_id" {
+  value = data.incidentio_custom_field_options.affected_service.by_value["Payments"]
+}
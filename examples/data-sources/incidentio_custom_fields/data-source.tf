@@ -0,0 +1,9 @@
+data "incidentio_custom_fields" "always_required_selects" {
+  field_type = "single_select"
+  required   = "always"
+  name_regex = "(?i)team"
+}
+
+output "always_required_selects" {
+  value = [for field in data.incidentio_custom_fields.always_required_selects.custom_fields : field.name]
+}
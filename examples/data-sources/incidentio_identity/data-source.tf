@@ -0,0 +1,14 @@
+data "incidentio_identity" "current" {}
+
+resource "incidentio_severity" "minor" {
+  name        = "Minor"
+  description = "Issues with low impact"
+  rank        = 1
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.incidentio_identity.current.roles, "manage_settings")
+      error_message = "The API key ${data.incidentio_identity.current.name} can't manage the incident.io settings."
+    }
+  }
+}
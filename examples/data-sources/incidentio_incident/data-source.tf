@@ -0,0 +1,14 @@
+data "incidentio_incident" "database_outage" {
+  reference = "INC-123"
+}
+
+output "database_outage_postmortem" {
+  value = data.incidentio_incident.database_outage.postmortem_document_url
+}
+
+output "database_outage_lead" {
+  value = one([
+    for assignment in data.incidentio_incident.database_outage.role_assignments :
+    assignment.assignee_name if assignment.role_type == "lead"
+  ])
+}
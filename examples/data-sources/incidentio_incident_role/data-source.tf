@@ -0,0 +1,7 @@
+data "incidentio_incident_role" "communications" {
+  name = "Communications Lead"
+}
+
+data "incidentio_incident_role" "lead" {
+  role_type = "lead"
+}
@@ -0,0 +1,5 @@
+data "incidentio_incident_roles" "all" {}
+
+output "required_roles" {
+  value = [for role in data.incidentio_incident_roles.all.incident_roles : role.name if role.required]
+}
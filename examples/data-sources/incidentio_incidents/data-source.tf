@@ -0,0 +1,19 @@
+data "incidentio_incidents" "live_major" {
+  status   = ["triage", "investigating", "fixing", "monitoring"]
+  mode     = "real"
+  severity = "Major"
+}
+
+# Refuse to change the severities while a major incident is ongoing.
+resource "incidentio_severity" "minor" {
+  name        = "Minor"
+  description = "Issues with minor impact."
+  rank        = 1
+
+  lifecycle {
+    precondition {
+      condition     = length(data.incidentio_incidents.live_major.incidents) == 0
+      error_message = "A major incident is ongoing: ${join(", ", data.incidentio_incidents.live_major.incidents[*].reference)}"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "incidentio_severities" "all" {}
+
+output "most_severe" {
+  value = data.incidentio_severities.all.severities[length(data.incidentio_severities.all.severities) - 1].name
+}
+
+output "major_id" {
+  value = data.incidentio_severities.all.by_name["Major"]
+}
@@ -0,0 +1,7 @@
+data "incidentio_severity" "major" {
+  name = "Major"
+}
+
+data "incidentio_severity" "lowest" {
+  rank = 1
+}
@@ -1,3 +1,17 @@
 provider "incidentio" {
   api_key = "" # you incident.io API key here
 }
+
+# Take over the existing severities, roles and custom fields with the same
+# name, instead of failing to create them.
+provider "incidentio" {
+  alias          = "onboarding"
+  api_key        = "" # you incident.io API key here
+  adopt_existing = true
+}
+
+# Read the API key from a secret manager.
+provider "incidentio" {
+  alias           = "vault"
+  api_key_command = "vault kv get -field=api_key secret/incident-io"
+}
@@ -7,6 +7,10 @@ resource "incidentio_custom_field" "test" {
   #show_before_closure  = true
   #show_before_creation = true
   #show_before_update = true
+  #show_in_announcement_post = false
+
+  # Set to false before destroying the custom field
+  #deletion_protection = true
 
   field_type = "multi_select"
 
@@ -13,3 +13,15 @@ resource "incidentio_incident_role" "lead" {
 - Provide regular, clear updates for stakeholders to let them know what’s happening
 EOF
 }
+
+# The built-in "reporter" role can't be created nor deleted, but it can be
+# adopted to manage its configuration.
+resource "incidentio_incident_role" "reporter" {
+  role_type  = "reporter"
+  name       = "Reporter"
+  short_form = "reporter"
+  required   = false
+
+  description  = "The person who reported the incident."
+  instructions = "Provide as much context as possible about what you noticed."
+}
@@ -0,0 +1,103 @@
+package incidentio
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+type ActionStatus string
+
+const (
+	ActionStatusOutstanding ActionStatus = "outstanding"
+	ActionStatusCompleted   ActionStatus = "completed"
+	ActionStatusDeleted     ActionStatus = "deleted"
+	ActionStatusNotDoing    ActionStatus = "not_doing"
+)
+
+// ActionStatuses returns all the valid action statuses.
+func ActionStatuses() []ActionStatus {
+	return []ActionStatus{
+		ActionStatusOutstanding,
+		ActionStatusCompleted,
+		ActionStatusDeleted,
+		ActionStatusNotDoing,
+	}
+}
+
+func ParseActionStatus(s string) (*ActionStatus, error) {
+	return parseEnum(s, ActionStatuses(), "action status")
+}
+
+type ExternalIssueProvider string
+
+const (
+	ExternalIssueProviderLinear     ExternalIssueProvider = "linear"
+	ExternalIssueProviderJira       ExternalIssueProvider = "jira"
+	ExternalIssueProviderJiraServer ExternalIssueProvider = "jira_server"
+	ExternalIssueProviderGitHub     ExternalIssueProvider = "github"
+	ExternalIssueProviderClubhouse  ExternalIssueProvider = "clubhouse"
+)
+
+// ExternalIssueProviders returns all the valid external issue providers.
+func ExternalIssueProviders() []ExternalIssueProvider {
+	return []ExternalIssueProvider{
+		ExternalIssueProviderLinear,
+		ExternalIssueProviderJira,
+		ExternalIssueProviderJiraServer,
+		ExternalIssueProviderGitHub,
+		ExternalIssueProviderClubhouse,
+	}
+}
+
+func ParseExternalIssueProvider(s string) (*ExternalIssueProvider, error) {
+	return parseEnum(s, ExternalIssueProviders(), "external issue provider")
+}
+
+type Action struct {
+	Id                     string                  `json:"id"`
+	IncidentId             string                  `json:"incident_id"`
+	Description            string                  `json:"description"`
+	Status                 ActionStatus            `json:"status"`
+	FollowUp               bool                    `json:"follow_up"`
+	ExternalIssueReference *ExternalIssueReference `json:"external_issue_reference"`
+	CompletedAt            *time.Time              `json:"completed_at"`
+	CreatedAt              time.Time               `json:"created_at"`
+	UpdatedAt              time.Time               `json:"updated_at"`
+}
+
+// ExternalIssueReference links an action to an issue in an external issue
+// tracker.
+type ExternalIssueReference struct {
+	Provider       ExternalIssueProvider `json:"provider"`
+	IssueName      string                `json:"issue_name"`
+	IssuePermalink string                `json:"issue_permalink"`
+}
+
+// Actions is used to query the actions of the incidents.
+//
+// Actions can't be created through the API, only listed and read.
+type Actions struct {
+	service *Service[struct{}, Action]
+}
+
+func (c *Client) Actions() *Actions {
+	return &Actions{
+		service: NewService[struct{}, Action](c, Endpoint{
+			Path:    "actions",
+			Key:     "action",
+			ListKey: "actions",
+		}),
+	}
+}
+
+// List returns the actions matching the query parameters, going through all
+// the pages.
+func (a *Actions) List(ctx context.Context, params url.Values) ([]Action, error) {
+	return a.service.ListWithParams(ctx, params)
+}
+
+// Get returns the action with the specified ID.
+func (a *Actions) Get(ctx context.Context, id string) (*Action, error) {
+	return a.service.Get(ctx, id)
+}
@@ -0,0 +1,78 @@
+package incidentio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestActionsList(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/actions", r.URL.Path)
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "01FDAG4SAP5TYPT98WGR2N7W91", r.URL.Query().Get("incident_id"))
+		require.Equal(t, "true", r.URL.Query().Get("is_follow_up"))
+
+		_, err := w.Write([]byte(`
+		{
+			"actions": [
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+					"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"description": "Call the fire brigade",
+					"status": "completed",
+					"follow_up": true,
+					"external_issue_reference": {
+						"issue_name": "INC-123",
+						"issue_permalink": "https://linear.app/incident-io/issue/INC-123",
+						"provider": "linear"
+					},
+					"completed_at": "2021-08-17T13:28:57.801578Z",
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				},
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG1",
+					"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"description": "Add more disk space",
+					"status": "outstanding",
+					"follow_up": true,
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	actions, err := client.Actions().List(context.Background(), url.Values{
+		"incident_id":  {"01FDAG4SAP5TYPT98WGR2N7W91"},
+		"is_follow_up": {"true"},
+	})
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+
+	action := actions[0]
+	assert.Equal(t, "Call the fire brigade", action.Description)
+	assert.Equal(t, incidentio.ActionStatusCompleted, action.Status)
+	assert.True(t, action.FollowUp)
+	assert.NotNil(t, action.CompletedAt)
+	require.NotNil(t, action.ExternalIssueReference)
+	assert.Equal(t, incidentio.ExternalIssueProviderLinear, action.ExternalIssueReference.Provider)
+	assert.Equal(t, "INC-123", action.ExternalIssueReference.IssueName)
+
+	assert.Equal(t, incidentio.ActionStatusOutstanding, actions[1].Status)
+	assert.Nil(t, actions[1].CompletedAt)
+	assert.Nil(t, actions[1].ExternalIssueReference)
+}
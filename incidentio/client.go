@@ -1,29 +1,44 @@
 package incidentio
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httputil"
 	"strings"
-	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const HostURL string = "https://api.incident.io"
 
+// DefaultUserAgent is the User-Agent header sent by the clients, unless
+// configured otherwise.
+const DefaultUserAgent string = "terraform-provider-incidentio"
+
 type Client struct {
 	hostURL   string
 	client    *http.Client
 	apiKey    string
 	debugHTTP bool
+	readOnly  bool
+	userAgent string
 }
 
+// ErrReadOnly is returned when a read-only client is asked to send a request
+// which could modify incident.io.
+var ErrReadOnly = errors.New("the client is read-only")
+
 func NewClient(apiKey string) *Client {
 	c := Client{
-		client:  &http.Client{Timeout: 10 * time.Second},
-		hostURL: HostURL,
-		apiKey:  apiKey,
+		client:    &http.Client{},
+		hostURL:   HostURL,
+		apiKey:    apiKey,
+		userAgent: DefaultUserAgent,
 	}
 
 	return &c
@@ -39,7 +54,35 @@ func (c *Client) WithDebug(debug bool) *Client {
 	return c
 }
 
-func (c *Client) newRequest(method string, path string, body io.Reader) (*http.Request, error) {
+// WithReadOnly prevents the client from sending any request but GET ones:
+// the other requests fail with ErrReadOnly before being sent.
+func (c *Client) WithReadOnly(readOnly bool) *Client {
+	c.readOnly = readOnly
+	return c
+}
+
+// WithUserAgent sets the User-Agent header sent with each request.
+func (c *Client) WithUserAgent(userAgent string) *Client {
+	c.userAgent = userAgent
+	return c
+}
+
+// AppendUserAgent adds a product token, like "my-tool/1.2.3", at the end of
+// the User-Agent header sent with each request.
+func (c *Client) AppendUserAgent(product string) *Client {
+	product = strings.TrimSpace(product)
+	if product != "" {
+		c.userAgent = strings.TrimSpace(c.userAgent + " " + product)
+	}
+	return c
+}
+
+// UserAgent returns the User-Agent header sent with each request.
+func (c *Client) UserAgent() string {
+	return c.userAgent
+}
+
+func (c *Client) newRequest(ctx context.Context, method string, path string, body io.Reader) (*http.Request, error) {
 
 	sep := "/"
 
@@ -48,11 +91,12 @@ func (c *Client) newRequest(method string, path string, body io.Reader) (*http.R
 	}
 
 	url := fmt.Sprintf("%s%s%s", c.hostURL, sep, path)
-	return http.NewRequest(method, url, body)
+	return http.NewRequestWithContext(ctx, method, url, body)
 }
 
 func (c *Client) doRequest(req *http.Request) (*http.Response, []byte, error) {
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	req.Header.Set("User-Agent", c.userAgent)
 
 	if c.debugHTTP {
 		reqDump, err := httputil.DumpRequestOut(req, true)
@@ -84,120 +128,68 @@ func (c *Client) doRequest(req *http.Request) (*http.Response, []byte, error) {
 	return res, body, err
 }
 
-func (c *Client) get(urlPart string, id string, target any) error {
-	if id == "" {
-		return fmt.Errorf("you must specify an ID to get")
+// do sends a request to the incident.io API and returns the body of the
+// response, if the response status is one of the expected status codes.
+//
+// The request is canceled when the context is done.
+func (c *Client) do(ctx context.Context, method string, path string, input any, expectedStatus []int) ([]byte, error) {
+	if c.readOnly && method != "GET" {
+		return nil, fmt.Errorf("%w, refusing to send %s %s", ErrReadOnly, method, path)
 	}
 
-	url := fmt.Sprintf("/v1/%s/%s", urlPart, id)
+	var reader io.Reader
 
-	request, err := c.newRequest("GET", url, nil)
-	if err != nil {
-		return err
-	}
-
-	res, body, err := c.doRequest(request)
-	if err != nil {
-		return err
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return NewErrors(body)
-	}
-
-	if err = json.Unmarshal(body, &target); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (c *Client) create(urlPart string, input any, target any) error {
-	data, err := json.Marshal(input)
-	if err != nil {
-		return err
-	}
-
-	url := fmt.Sprintf("/v1/%s", urlPart)
-	reader := strings.NewReader(string(data))
-
-	request, err := c.newRequest("POST", url, reader)
-	if err != nil {
-		return err
-	}
-
-	res, body, err := c.doRequest(request)
-	if err != nil {
-		return err
-	}
-
-	if res.StatusCode != http.StatusCreated {
-		return NewErrors(body)
-	}
-
-	if err = json.Unmarshal(body, &target); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (c *Client) update(urlPart string, id string, input any, target any) error {
-	if id == "" {
-		return fmt.Errorf("you must specify an ID to update")
-	}
+	if input != nil {
+		data, err := json.Marshal(input)
+		if err != nil {
+			return nil, err
+		}
 
-	data, err := json.Marshal(input)
-	if err != nil {
-		return err
+		reader = bytes.NewReader(data)
 	}
 
-	url := fmt.Sprintf("/v1/%s/%s", urlPart, id)
-	reader := strings.NewReader(string(data))
-
-	request, err := c.newRequest("PUT", url, reader)
+	request, err := c.newRequest(ctx, method, path, reader)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, body, err := c.doRequest(request)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return NewErrors(body)
+	for _, status := range expectedStatus {
+		if res.StatusCode == status {
+			return body, nil
+		}
 	}
 
-	if err = json.Unmarshal(body, &target); err != nil {
-		return err
+	// The API returned a success status we didn't expect: the request most
+	// likely succeeded, so don't fail and leave dangling objects behind.
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		tflog.Warn(ctx, fmt.Sprintf("%s %s returned status %d instead of %v, assuming success", method, path, res.StatusCode, expectedStatus))
+		return body, nil
 	}
 
-	return nil
+	return nil, newResponseError(res.StatusCode, body)
 }
 
-func (c *Client) delete(urlPart string, id string) error {
-	if id == "" {
-		return fmt.Errorf("you must specify an ID to delete")
-	}
-
-	url := fmt.Sprintf("/v1/%s/%s", urlPart, id)
-
-	request, err := c.newRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
+// newResponseError returns the error described in the body of a failed
+// response, or a generic error if the body can't be decoded.
+func newResponseError(statusCode int, body []byte) error {
+	err := NewErrors(body)
 
-	res, body, err := c.doRequest(request)
-	if err != nil {
-		return err
+	var errorResponse *IncidentIOErrorResponse
+	if !errors.As(err, &errorResponse) {
+		return &IncidentIOErrorResponse{
+			Type:   http.StatusText(statusCode),
+			Status: statusCode,
+		}
 	}
 
-	// most of the APIs return "204 No Content" upon successful deletion
-	// TODO: for some reasons, severities return 204 on successful deletion
-	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusAccepted {
-		return NewErrors(body)
+	if errorResponse.Status == 0 {
+		errorResponse.Status = statusCode
 	}
 
-	return nil
+	return errorResponse
 }
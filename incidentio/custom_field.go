@@ -1,7 +1,7 @@
 package incidentio
 
 import (
-	"fmt"
+	"time"
 )
 
 type FieldType string
@@ -14,15 +14,13 @@ const (
 	Numeric      FieldType = "numeric"
 )
 
-func ParseFieldType(s string) (*FieldType, error) {
-	v := FieldType(s)
-
-	switch v {
-	case SingleSelect, MultiSelect, Text, Link, Numeric:
-		return &v, nil
-	}
+// FieldTypes returns all the valid field types.
+func FieldTypes() []FieldType {
+	return []FieldType{SingleSelect, MultiSelect, Text, Link, Numeric}
+}
 
-	return nil, fmt.Errorf("%v is not a valid field type", s)
+func ParseFieldType(s string) (*FieldType, error) {
+	return parseEnum(s, FieldTypes(), "field type")
 }
 
 type FieldRequirement string
@@ -33,83 +31,60 @@ const (
 	Always        FieldRequirement = "always"
 )
 
-func ParseFieldRequirement(s string) (*FieldRequirement, error) {
-	v := FieldRequirement(s)
-
-	switch v {
-	case Never, BeforeClosure, Always:
-		return &v, nil
-	}
+// FieldRequirements returns all the valid field requirements.
+func FieldRequirements() []FieldRequirement {
+	return []FieldRequirement{Never, BeforeClosure, Always}
+}
 
-	return nil, fmt.Errorf("%v is not a valid field requirement", s)
+func ParseFieldRequirement(s string) (*FieldRequirement, error) {
+	return parseEnum(s, FieldRequirements(), "field requirement")
 }
 
 type CustomField struct {
-	Name               string              `json:"name"`
-	Description        string              `json:"description"`
-	Required           FieldRequirement    `json:"required"`
-	ShowBeforeClosure  bool                `json:"show_before_closure"`
-	ShowBeforeCreation bool                `json:"show_before_creation"`
-	ShowBeforeUpdate   bool                `json:"show_before_update"`
-	FieldType          FieldType           `json:"field_type"`
-	Options            []CustomFieldOption `json:"options"`
+	Name                   string              `json:"name"`
+	Description            string              `json:"description"`
+	Required               FieldRequirement    `json:"required"`
+	ShowBeforeClosure      bool                `json:"show_before_closure"`
+	ShowBeforeCreation     bool                `json:"show_before_creation"`
+	ShowBeforeUpdate       bool                `json:"show_before_update"`
+	ShowInAnnouncementPost bool                `json:"show_in_announcement_post"`
+	FieldType              FieldType           `json:"field_type"`
+	Options                []CustomFieldOption `json:"options"`
 }
 
 type CustomFieldMetadata struct {
 	CustomField
 
-	Id        string `json:"id"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	Id        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type CustomFieldResponse struct {
 	CustomField CustomFieldMetadata `json:"custom_field"`
 }
 
-// CustomFields is used to query custom field options
+// CustomFields is used to query custom fields
 type CustomFields struct {
-	client  *Client
-	urlPart string
+	*Service[CustomField, CustomFieldMetadata]
 }
 
 func (c *Client) CustomFields() *CustomFields {
 	return &CustomFields{
-		client:  c,
-		urlPart: "custom_fields",
-	}
-}
-
-func (i *CustomFields) Get(id string) (*CustomFieldResponse, error) {
-	response := &CustomFieldResponse{}
-
-	if err := i.client.get(i.urlPart, id, &response); err != nil {
-		return nil, err
+		NewService[CustomField, CustomFieldMetadata](c, Endpoint{
+			Path:    "custom_fields",
+			Key:     "custom_field",
+			ListKey: "custom_fields",
+		}).WithLookup(Lookup[CustomField, CustomFieldMetadata]{
+			Match: func(field CustomField, existing CustomFieldMetadata) bool {
+				return field.Name == existing.Name
+			},
+			ID: func(existing CustomFieldMetadata) string {
+				return existing.Id
+			},
+			CreatedAt: func(existing CustomFieldMetadata) time.Time {
+				return existing.CreatedAt
+			},
+		}),
 	}
-
-	return response, nil
-}
-
-func (i *CustomFields) Create(field CustomField) (*CustomFieldResponse, error) {
-	response := &CustomFieldResponse{}
-
-	if err := i.client.create(i.urlPart, field, &response); err != nil {
-		return nil, err
-	}
-
-	return response, nil
-}
-
-func (i *CustomFields) Update(id string, field CustomField) (*CustomFieldResponse, error) {
-	response := &CustomFieldResponse{}
-
-	if err := i.client.update(i.urlPart, id, field, &response); err != nil {
-		return nil, err
-	}
-
-	return response, nil
-}
-
-func (i *CustomFields) Delete(id string) error {
-	return i.client.delete(i.urlPart, id)
 }
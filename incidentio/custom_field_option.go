@@ -1,5 +1,11 @@
 package incidentio
 
+import (
+	"context"
+	"net/url"
+	"sort"
+)
+
 type CustomFieldOption struct {
 	CustomFieldId string `json:"custom_field_id"`
 	SortKey       int64  `json:"sort_key"`
@@ -18,47 +24,41 @@ type CustomFieldOptionResponse struct {
 
 // CustomFieldOptions is used to query custom field options
 type CustomFieldOptions struct {
-	client  *Client
-	urlPart string
+	*Service[CustomFieldOption, CustomFieldOptionMetadata]
 }
 
 func (c *Client) CustomFieldOptions() *CustomFieldOptions {
 	return &CustomFieldOptions{
-		client:  c,
-		urlPart: "custom_field_options",
-	}
-}
-
-func (i *CustomFieldOptions) Get(id string) (*CustomFieldOptionResponse, error) {
-	response := &CustomFieldOptionResponse{}
-
-	if err := i.client.get(i.urlPart, id, &response); err != nil {
-		return nil, err
+		NewService[CustomFieldOption, CustomFieldOptionMetadata](c, Endpoint{
+			Path:     "custom_field_options",
+			Key:      "custom_field_option",
+			ListKey:  "custom_field_options",
+			PageSize: 100,
+		}).WithLookup(Lookup[CustomFieldOption, CustomFieldOptionMetadata]{
+			Params: func(option CustomFieldOption) url.Values {
+				return url.Values{"custom_field_id": {option.CustomFieldId}}
+			},
+			Match: func(option CustomFieldOption, existing CustomFieldOptionMetadata) bool {
+				return option.CustomFieldId == existing.CustomFieldId && option.Value == existing.Value
+			},
+			ID: func(existing CustomFieldOptionMetadata) string {
+				return existing.Id
+			},
+		}),
 	}
-
-	return response, nil
 }
 
-func (i *CustomFieldOptions) Create(customFieldOption CustomFieldOption) (*CustomFieldOptionResponse, error) {
-	response := &CustomFieldOptionResponse{}
-
-	if err := i.client.create(i.urlPart, customFieldOption, &response); err != nil {
+// ListForCustomField returns all the options of the custom field, going
+// through all the pages, ordered by sort key.
+func (c *CustomFieldOptions) ListForCustomField(ctx context.Context, customFieldId string) ([]CustomFieldOptionMetadata, error) {
+	options, err := c.ListWithParams(ctx, url.Values{"custom_field_id": {customFieldId}})
+	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
-}
-
-func (i *CustomFieldOptions) Update(id string, customFieldOption CustomFieldOption) (*CustomFieldOptionResponse, error) {
-	response := &CustomFieldOptionResponse{}
-
-	if err := i.client.update(i.urlPart, id, customFieldOption, &response); err != nil {
-		return nil, err
-	}
-
-	return response, nil
-}
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].SortKey < options[j].SortKey
+	})
 
-func (i *CustomFieldOptions) Delete(id string) error {
-	return i.client.delete(i.urlPart, id)
+	return options, nil
 }
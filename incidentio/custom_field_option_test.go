@@ -0,0 +1,81 @@
+package incidentio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestCustomFieldOptionsListForCustomField(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/custom_field_options", r.URL.Path)
+		require.Equal(t, "01G44T2BWJY0ZMV945X32RAJ5C", r.URL.Query().Get("custom_field_id"))
+		_, err := w.Write([]byte(`
+		{
+			"custom_field_options": [
+				{"id": "2", "custom_field_id": "01G44T2BWJY0ZMV945X32RAJ5C", "value": "Payments", "sort_key": 20},
+				{"id": "1", "custom_field_id": "01G44T2BWJY0ZMV945X32RAJ5C", "value": "Core", "sort_key": 10}
+			]
+		}
+		`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	options, err := client.CustomFieldOptions().ListForCustomField(context.Background(), "01G44T2BWJY0ZMV945X32RAJ5C")
+	require.NoError(t, err)
+	require.Len(t, options, 2)
+	assert.Equal(t, "Core", options[0].Value)
+	assert.Equal(t, "1", options[0].Id)
+	assert.Equal(t, int64(20), options[1].SortKey)
+}
+
+func TestCustomFieldOptionsListForCustomFieldPages(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/custom_field_options", r.URL.Path)
+		require.Equal(t, "01G44T2BWJY0ZMV945X32RAJ5C", r.URL.Query().Get("custom_field_id"))
+		require.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+		var err error
+		switch r.URL.Query().Get("after") {
+		case "":
+			_, err = w.Write([]byte(`
+			{
+				"custom_field_options": [
+					{"id": "3", "custom_field_id": "01G44T2BWJY0ZMV945X32RAJ5C", "value": "Search", "sort_key": 30}
+				],
+				"pagination_meta": {"after": "3", "page_size": 1, "total_record_count": 2}
+			}`))
+		case "3":
+			_, err = w.Write([]byte(`
+			{
+				"custom_field_options": [
+					{"id": "1", "custom_field_id": "01G44T2BWJY0ZMV945X32RAJ5C", "value": "Core", "sort_key": 10}
+				],
+				"pagination_meta": {"page_size": 1, "total_record_count": 2}
+			}`))
+		default:
+			t.Fatalf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	options, err := client.CustomFieldOptions().ListForCustomField(context.Background(), "01G44T2BWJY0ZMV945X32RAJ5C")
+	require.NoError(t, err)
+	require.Len(t, options, 2)
+	assert.Equal(t, "Core", options[0].Value)
+	assert.Equal(t, "Search", options[1].Value)
+}
@@ -1,11 +1,13 @@
 package incidentio_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +29,7 @@ func TestCustomFieldsGet(t *testing.T) {
 				"required": "always",
 				"show_before_creation": false,
 				"show_before_closure": true,
+				"show_in_announcement_post": true,
 				"options": [],
 				"created_at": "2022-05-28T07:46:07.385Z",
 				"updated_at": "2022-05-28T07:46:07.385Z"
@@ -40,16 +43,19 @@ func TestCustomFieldsGet(t *testing.T) {
 
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
-	field, err := client.CustomFields().Get("01G44T2BWJY0ZMV945X32RAJ5C")
+	field, err := client.CustomFields().Get(context.Background(), "01G44T2BWJY0ZMV945X32RAJ5C")
 	require.NoError(t, err)
 
-	assert.Equal(t, "Affected Team", field.CustomField.Name)
-	assert.Equal(t, "The team which was responsible for resolving this incident.", field.CustomField.Description)
-	assert.Equal(t, incidentio.FieldRequirement("always"), field.CustomField.Required)
-	assert.Equal(t, false, field.CustomField.ShowBeforeCreation)
-	assert.Equal(t, true, field.CustomField.ShowBeforeClosure)
-	assert.Equal(t, []incidentio.CustomFieldOption{}, field.CustomField.Options)
-	assert.Equal(t, incidentio.FieldType("multi_select"), field.CustomField.FieldType)
+	assert.Equal(t, "Affected Team", field.Name)
+	assert.Equal(t, "The team which was responsible for resolving this incident.", field.Description)
+	assert.Equal(t, incidentio.FieldRequirement("always"), field.Required)
+	assert.Equal(t, false, field.ShowBeforeCreation)
+	assert.Equal(t, true, field.ShowBeforeClosure)
+	assert.Equal(t, true, field.ShowInAnnouncementPost)
+	assert.Equal(t, []incidentio.CustomFieldOption{}, field.Options)
+	assert.Equal(t, incidentio.FieldType("multi_select"), field.FieldType)
+	assert.Equal(t, time.Date(2022, 5, 28, 7, 46, 7, 385000000, time.UTC), field.CreatedAt)
+	assert.Equal(t, time.Date(2022, 5, 28, 7, 46, 7, 385000000, time.UTC), field.UpdatedAt)
 }
 
 func TestCustomFieldsCreate(t *testing.T) {
@@ -69,8 +75,6 @@ func TestCustomFieldsCreate(t *testing.T) {
 		fieldResp := &incidentio.CustomFieldResponse{
 			CustomField: incidentio.CustomFieldMetadata{
 				Id:          "id123",
-				CreatedAt:   "",
-				UpdatedAt:   "",
 				CustomField: *field,
 			},
 		}
@@ -86,20 +90,22 @@ func TestCustomFieldsCreate(t *testing.T) {
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
 	request := incidentio.CustomField{
-		Name:               "some name",
-		Description:        "some description",
-		Required:           "always",
-		ShowBeforeCreation: false,
-		ShowBeforeClosure:  true,
-		FieldType:          "number",
+		Name:                   "some name",
+		Description:            "some description",
+		Required:               "always",
+		ShowBeforeCreation:     false,
+		ShowBeforeClosure:      true,
+		ShowInAnnouncementPost: true,
+		FieldType:              "number",
 	}
 
-	response, err := client.CustomFields().Create(request)
+	response, err := client.CustomFields().Create(context.Background(), request, false)
 	require.NoError(t, err)
 
-	assert.Equal(t, "id123", response.CustomField.Id)
-	assert.Equal(t, request.Name, response.CustomField.Name)
-	assert.Equal(t, request.Description, response.CustomField.Description)
+	assert.Equal(t, "id123", response.Id)
+	assert.Equal(t, request.Name, response.Name)
+	assert.Equal(t, request.Description, response.Description)
+	assert.Equal(t, request.ShowInAnnouncementPost, response.ShowInAnnouncementPost)
 }
 
 func TestCustomFieldsUpdate(t *testing.T) {
@@ -119,8 +125,6 @@ func TestCustomFieldsUpdate(t *testing.T) {
 		fieldResp := &incidentio.CustomFieldResponse{
 			CustomField: incidentio.CustomFieldMetadata{
 				Id:          "id123",
-				CreatedAt:   "",
-				UpdatedAt:   "",
 				CustomField: *field,
 			},
 		}
@@ -136,20 +140,22 @@ func TestCustomFieldsUpdate(t *testing.T) {
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
 	request := incidentio.CustomField{
-		Name:               "some name",
-		Description:        "some description",
-		Required:           "always",
-		ShowBeforeCreation: false,
-		ShowBeforeClosure:  true,
-		FieldType:          "number",
+		Name:                   "some name",
+		Description:            "some description",
+		Required:               "always",
+		ShowBeforeCreation:     false,
+		ShowBeforeClosure:      true,
+		ShowInAnnouncementPost: true,
+		FieldType:              "number",
 	}
 
-	response, err := client.CustomFields().Update("id123", request)
+	response, err := client.CustomFields().Update(context.Background(), "id123", request)
 	require.NoError(t, err)
 
-	assert.Equal(t, "id123", response.CustomField.Id)
-	assert.Equal(t, request.Name, response.CustomField.Name)
-	assert.Equal(t, request.Description, response.CustomField.Description)
+	assert.Equal(t, "id123", response.Id)
+	assert.Equal(t, request.Name, response.Name)
+	assert.Equal(t, request.Description, response.Description)
+	assert.Equal(t, request.ShowInAnnouncementPost, response.ShowInAnnouncementPost)
 }
 
 func TestCustomFieldsDelete(t *testing.T) {
@@ -172,6 +178,6 @@ func TestCustomFieldsDelete(t *testing.T) {
 
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
-	err := client.CustomFields().Delete("id123")
+	err := client.CustomFields().Delete(context.Background(), "id123")
 	require.NoError(t, err)
 }
@@ -0,0 +1,18 @@
+package incidentio
+
+import (
+	"fmt"
+)
+
+// parseEnum returns a pointer to the value matching s, if s is one of the
+// valid values of the enumeration called name.
+func parseEnum[T ~string](s string, valid []T, name string) (*T, error) {
+	for _, v := range valid {
+		if string(v) == s {
+			v := v
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%v is not a valid %s", s, name)
+}
@@ -0,0 +1,85 @@
+package incidentio_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// testEnum checks that all the values of an enumeration can be parsed, that
+// invalid values are rejected, and that values survive a JSON round-trip.
+func testEnum[T ~string](t *testing.T, values []T, parse func(string) (*T, error)) {
+	t.Helper()
+
+	require.NotEmpty(t, values)
+
+	for _, value := range values {
+		parsed, err := parse(string(value))
+		require.NoError(t, err)
+		assert.Equal(t, value, *parsed)
+
+		type document struct {
+			Value T `json:"value"`
+		}
+
+		data, err := json.Marshal(document{Value: value})
+		require.NoError(t, err)
+		assert.JSONEq(t, fmt.Sprintf(`{"value": %q}`, value), string(data))
+
+		decoded := document{}
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded.Value)
+	}
+
+	_, err := parse("not-a-valid-value")
+	assert.Error(t, err)
+
+	_, err = parse("")
+	assert.Error(t, err)
+}
+
+func TestEnums(t *testing.T) {
+	t.Run("FieldType", func(t *testing.T) {
+		testEnum(t, incidentio.FieldTypes(), incidentio.ParseFieldType)
+	})
+	t.Run("FieldRequirement", func(t *testing.T) {
+		testEnum(t, incidentio.FieldRequirements(), incidentio.ParseFieldRequirement)
+	})
+	t.Run("RoleType", func(t *testing.T) {
+		testEnum(t, incidentio.RoleTypes(), incidentio.ParseRoleType)
+	})
+	t.Run("IncidentStatus", func(t *testing.T) {
+		testEnum(t, incidentio.IncidentStatuses(), incidentio.ParseIncidentStatus)
+	})
+	t.Run("IncidentMode", func(t *testing.T) {
+		testEnum(t, incidentio.IncidentModes(), incidentio.ParseIncidentMode)
+	})
+	t.Run("IncidentVisibility", func(t *testing.T) {
+		testEnum(t, incidentio.IncidentVisibilities(), incidentio.ParseIncidentVisibility)
+	})
+	t.Run("ActionStatus", func(t *testing.T) {
+		testEnum(t, incidentio.ActionStatuses(), incidentio.ParseActionStatus)
+	})
+	t.Run("UserRole", func(t *testing.T) {
+		testEnum(t, incidentio.UserRoles(), incidentio.ParseUserRole)
+	})
+	t.Run("ExternalIssueProvider", func(t *testing.T) {
+		testEnum(t, incidentio.ExternalIssueProviders(), incidentio.ParseExternalIssueProvider)
+	})
+	t.Run("APIKeyRole", func(t *testing.T) {
+		testEnum(t, incidentio.APIKeyRoles(), incidentio.ParseAPIKeyRole)
+	})
+}
+
+func TestParseEnumError(t *testing.T) {
+	_, err := incidentio.ParseIncidentStatus("resolved")
+	assert.EqualError(t, err, "resolved is not a valid incident status")
+
+	_, err = incidentio.ParseFieldType("number")
+	assert.EqualError(t, err, "number is not a valid field type")
+}
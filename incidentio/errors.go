@@ -3,6 +3,7 @@ package incidentio
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -52,3 +53,36 @@ func IsErrorStatus(err error, statusCode int) bool {
 	var target *IncidentIOErrorResponse
 	return errors.As(err, &target) && target.Status == statusCode
 }
+
+// PartiallyCreatedError is returned when incident.io created an object but
+// the object couldn't be read back. ID is the identifier of the created
+// object, so that it can still be tracked.
+type PartiallyCreatedError struct {
+	ID  string
+	Err error
+}
+
+func (e *PartiallyCreatedError) Error() string {
+	return fmt.Sprintf("object %s has been created but couldn't be read: %s", e.ID, e.Err)
+}
+
+func (e *PartiallyCreatedError) Unwrap() error {
+	return e.Err
+}
+
+// ExistingObjectError is returned by Service.Create, along with the object,
+// when the creation failed without telling whether the object has been
+// created or not, and a matching object which may have existed before has
+// been updated with the input instead. ID is the identifier of this object.
+type ExistingObjectError struct {
+	ID  string
+	Err error
+}
+
+func (e *ExistingObjectError) Error() string {
+	return fmt.Sprintf("unable to create the object (%s), the existing object %s has been updated instead", e.Err, e.ID)
+}
+
+func (e *ExistingObjectError) Unwrap() error {
+	return e.Err
+}
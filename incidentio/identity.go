@@ -0,0 +1,51 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+)
+
+type APIKeyRole string
+
+const (
+	APIKeyRoleViewer          APIKeyRole = "viewer"
+	APIKeyRoleIncidentCreator APIKeyRole = "incident_creator"
+	APIKeyRoleGlobalAccess    APIKeyRole = "global_access"
+	APIKeyRoleManageSettings  APIKeyRole = "manage_settings"
+)
+
+// APIKeyRoles returns all the valid API key roles.
+func APIKeyRoles() []APIKeyRole {
+	return []APIKeyRole{APIKeyRoleViewer, APIKeyRoleIncidentCreator, APIKeyRoleGlobalAccess, APIKeyRoleManageSettings}
+}
+
+func ParseAPIKeyRole(s string) (*APIKeyRole, error) {
+	return parseEnum(s, APIKeyRoles(), "API key role")
+}
+
+// Identity describes the API key used by the client.
+type Identity struct {
+	Name  string       `json:"name"`
+	Roles []APIKeyRole `json:"roles"`
+}
+
+// HasRole returns true if the API key has the role.
+func (i Identity) HasRole(role APIKeyRole) bool {
+	for _, r := range i.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Identity returns the identity of the API key used by the client.
+func (c *Client) Identity(ctx context.Context) (*Identity, error) {
+	body, err := c.do(ctx, "GET", "/v1/identity", nil, []int{http.StatusOK})
+	if err != nil {
+		return nil, err
+	}
+
+	return unwrap[Identity](body, "identity")
+}
@@ -0,0 +1,33 @@
+package incidentio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestIdentity(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/identity", r.URL.Path)
+		require.Equal(t, "GET", r.Method)
+		_, err := w.Write([]byte(`{"identity": {"name": "Terraform", "roles": ["viewer", "manage_settings"]}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	identity, err := client.Identity(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Terraform", identity.Name)
+	assert.Equal(t, []incidentio.APIKeyRole{incidentio.APIKeyRoleViewer, incidentio.APIKeyRoleManageSettings}, identity.Roles)
+	assert.True(t, identity.HasRole(incidentio.APIKeyRoleManageSettings))
+	assert.False(t, identity.HasRole(incidentio.APIKeyRoleIncidentCreator))
+}
@@ -0,0 +1,214 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type IncidentStatus string
+
+const (
+	IncidentStatusTriage        IncidentStatus = "triage"
+	IncidentStatusInvestigating IncidentStatus = "investigating"
+	IncidentStatusFixing        IncidentStatus = "fixing"
+	IncidentStatusMonitoring    IncidentStatus = "monitoring"
+	IncidentStatusClosed        IncidentStatus = "closed"
+	IncidentStatusDeclined      IncidentStatus = "declined"
+)
+
+// IncidentStatuses returns all the valid incident statuses.
+func IncidentStatuses() []IncidentStatus {
+	return []IncidentStatus{
+		IncidentStatusTriage,
+		IncidentStatusInvestigating,
+		IncidentStatusFixing,
+		IncidentStatusMonitoring,
+		IncidentStatusClosed,
+		IncidentStatusDeclined,
+	}
+}
+
+func ParseIncidentStatus(s string) (*IncidentStatus, error) {
+	return parseEnum(s, IncidentStatuses(), "incident status")
+}
+
+type IncidentMode string
+
+const (
+	IncidentModeReal     IncidentMode = "real"
+	IncidentModeTest     IncidentMode = "test"
+	IncidentModeTutorial IncidentMode = "tutorial"
+)
+
+// IncidentModes returns all the valid incident modes.
+func IncidentModes() []IncidentMode {
+	return []IncidentMode{IncidentModeReal, IncidentModeTest, IncidentModeTutorial}
+}
+
+func ParseIncidentMode(s string) (*IncidentMode, error) {
+	return parseEnum(s, IncidentModes(), "incident mode")
+}
+
+type IncidentVisibility string
+
+const (
+	IncidentVisibilityPublic  IncidentVisibility = "public"
+	IncidentVisibilityPrivate IncidentVisibility = "private"
+)
+
+// IncidentVisibilities returns all the valid incident visibilities.
+func IncidentVisibilities() []IncidentVisibility {
+	return []IncidentVisibility{IncidentVisibilityPublic, IncidentVisibilityPrivate}
+}
+
+func ParseIncidentVisibility(s string) (*IncidentVisibility, error) {
+	return parseEnum(s, IncidentVisibilities(), "incident visibility")
+}
+
+type Incident struct {
+	Id                      string                   `json:"id"`
+	Reference               string                   `json:"reference"`
+	Name                    string                   `json:"name"`
+	Summary                 string                   `json:"summary"`
+	Status                  IncidentStatus           `json:"status"`
+	Mode                    IncidentMode             `json:"mode"`
+	Visibility              IncidentVisibility       `json:"visibility"`
+	Severity                *SeverityMetadata        `json:"severity"`
+	IncidentType            *IncidentType            `json:"incident_type"`
+	Permalink               string                   `json:"permalink"`
+	PostmortemDocumentURL   string                   `json:"postmortem_document_url"`
+	SlackChannelId          string                   `json:"slack_channel_id"`
+	SlackChannelName        string                   `json:"slack_channel_name"`
+	CallURL                 string                   `json:"call_url"`
+	IncidentRoleAssignments []IncidentRoleAssignment `json:"incident_role_assignments"`
+	CustomFieldEntries      []CustomFieldEntry       `json:"custom_field_entries"`
+	Timestamps              []IncidentTimestamp      `json:"timestamps"`
+	CreatedAt               time.Time                `json:"created_at"`
+	UpdatedAt               time.Time                `json:"updated_at"`
+}
+
+type IncidentType struct {
+	Id                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	IsDefault            bool      `json:"is_default"`
+	PrivateIncidentsOnly bool      `json:"private_incidents_only"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+type IncidentRoleAssignment struct {
+	Role     IncidentRoleMetadata `json:"role"`
+	Assignee *User                `json:"assignee"`
+}
+
+type IncidentTimestamp struct {
+	Name           string     `json:"name"`
+	LastOccurredAt *time.Time `json:"last_occurred_at"`
+}
+
+type CustomFieldEntry struct {
+	CustomField CustomFieldTypeInfo `json:"custom_field"`
+	Values      []CustomFieldValue  `json:"values"`
+}
+
+// CustomFieldTypeInfo describes the custom field of an entry.
+type CustomFieldTypeInfo struct {
+	Id          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	FieldType   FieldType                   `json:"field_type"`
+	Options     []CustomFieldOptionMetadata `json:"options"`
+}
+
+type CustomFieldValue struct {
+	ValueLink    string                     `json:"value_link,omitempty"`
+	ValueNumeric string                     `json:"value_numeric,omitempty"`
+	ValueOption  *CustomFieldOptionMetadata `json:"value_option,omitempty"`
+	ValueText    string                     `json:"value_text,omitempty"`
+}
+
+// Incidents is used to query incidents
+//
+// Incidents are declared from Slack or the dashboard, not managed by the
+// provider, so they are only listed and read.
+type Incidents struct {
+	service *Service[struct{}, Incident]
+}
+
+func (c *Client) Incidents() *Incidents {
+	return &Incidents{
+		service: NewService[struct{}, Incident](c, Endpoint{
+			Path:     "incidents",
+			Key:      "incident",
+			ListKey:  "incidents",
+			PageSize: 100,
+		}),
+	}
+}
+
+// List returns all the incidents, going through all the pages.
+func (i *Incidents) List(ctx context.Context) ([]Incident, error) {
+	return i.service.List(ctx)
+}
+
+// ListPages calls fn with each page of the incidents matching the query
+// parameters, until fn returns false.
+func (i *Incidents) ListPages(ctx context.Context, params url.Values, fn func(page []Incident) bool) error {
+	return i.service.ListPages(ctx, params, fn)
+}
+
+// Get returns the incident with the specified ID.
+func (i *Incidents) Get(ctx context.Context, id string) (*Incident, error) {
+	return i.service.Get(ctx, id)
+}
+
+// GetByReference returns the incident with the specified human readable
+// reference, like "INC-123".
+//
+// The API can only get incidents by ID, and doesn't document the order of the
+// incidents list, so this walks through the list until the reference is found.
+func (i *Incidents) GetByReference(ctx context.Context, reference string) (*Incident, error) {
+	if !isIncidentReference(reference) {
+		return nil, fmt.Errorf("invalid incident reference %q, expected a reference like INC-123", reference)
+	}
+
+	var found *Incident
+
+	err := i.ListPages(ctx, nil, func(page []Incident) bool {
+		for _, incident := range page {
+			if strings.EqualFold(incident.Reference, reference) {
+				incident := incident
+				found = &incident
+				return false
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no incident found with reference %s", reference)
+	}
+
+	return found, nil
+}
+
+// isIncidentReference returns true if the reference has a numeric part, like
+// "INC-123".
+func isIncidentReference(reference string) bool {
+	_, number, found := strings.Cut(reference, "-")
+	if !found {
+		return false
+	}
+
+	_, err := strconv.Atoi(number)
+	return err == nil
+}
@@ -1,5 +1,34 @@
 package incidentio
 
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type RoleType string
+
+const (
+	RoleTypeLead     RoleType = "lead"
+	RoleTypeReporter RoleType = "reporter"
+	RoleTypeCustom   RoleType = "custom"
+)
+
+// RoleTypes returns all the valid role types.
+func RoleTypes() []RoleType {
+	return []RoleType{RoleTypeLead, RoleTypeReporter, RoleTypeCustom}
+}
+
+func ParseRoleType(s string) (*RoleType, error) {
+	return parseEnum(s, RoleTypes(), "role type")
+}
+
+// IsBuiltIn returns true if the role type designates one of the roles
+// provided by incident.io, which can be updated but not created nor deleted.
+func (r RoleType) IsBuiltIn() bool {
+	return r == RoleTypeLead || r == RoleTypeReporter
+}
+
 type IncidentRole struct {
 	Name         string `json:"name"`
 	Description  string `json:"description"`
@@ -11,10 +40,10 @@ type IncidentRole struct {
 type IncidentRoleMetadata struct {
 	IncidentRole
 
-	Id        string `json:"id"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
-	RoleType  string `json:"role_type"`
+	Id        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	RoleType  RoleType  `json:"role_type"`
 }
 
 type IncidentRoleResponse struct {
@@ -23,47 +52,45 @@ type IncidentRoleResponse struct {
 
 // IncidentRoles is used to query incident roles
 type IncidentRoles struct {
-	client  *Client
-	urlPart string
+	*Service[IncidentRole, IncidentRoleMetadata]
 }
 
 func (c *Client) IncidentRoles() *IncidentRoles {
 	return &IncidentRoles{
-		client:  c,
-		urlPart: "incident_roles",
+		NewService[IncidentRole, IncidentRoleMetadata](c, Endpoint{
+			Path:    "incident_roles",
+			Key:     "incident_role",
+			ListKey: "incident_roles",
+		}).WithLookup(Lookup[IncidentRole, IncidentRoleMetadata]{
+			Match: func(role IncidentRole, existing IncidentRoleMetadata) bool {
+				return role.Name == existing.Name
+			},
+			ID: func(existing IncidentRoleMetadata) string {
+				return existing.Id
+			},
+			CreatedAt: func(existing IncidentRoleMetadata) time.Time {
+				return existing.CreatedAt
+			},
+		}),
 	}
 }
 
-func (i *IncidentRoles) Get(id string) (*IncidentRoleResponse, error) {
-	response := &IncidentRoleResponse{}
-
-	if err := i.client.get(i.urlPart, id, &response); err != nil {
+// GetByRoleType returns the first incident role of the specified type.
+//
+// This is mostly useful to find the built-in "lead" and "reporter" roles, as
+// there is only one of each per organization.
+func (i *IncidentRoles) GetByRoleType(ctx context.Context, roleType RoleType) (*IncidentRoleMetadata, error) {
+	roles, err := i.List(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
-}
-
-func (i *IncidentRoles) Create(role IncidentRole) (*IncidentRoleResponse, error) {
-	response := &IncidentRoleResponse{}
-
-	if err := i.client.create(i.urlPart, role, &response); err != nil {
-		return nil, err
+	for _, role := range roles {
+		if role.RoleType == roleType {
+			role := role
+			return &role, nil
+		}
 	}
 
-	return response, nil
-}
-
-func (i *IncidentRoles) Update(id string, role IncidentRole) (*IncidentRoleResponse, error) {
-	response := &IncidentRoleResponse{}
-
-	if err := i.client.update(i.urlPart, id, role, &response); err != nil {
-		return nil, err
-	}
-
-	return response, nil
-}
-
-func (i *IncidentRoles) Delete(id string) error {
-	return i.client.delete(i.urlPart, id)
+	return nil, fmt.Errorf("no incident role found with role type %s", roleType)
 }
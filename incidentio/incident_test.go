@@ -0,0 +1,209 @@
+package incidentio_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestIncidentsList(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/incidents", r.URL.Path)
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+		var err error
+		switch r.URL.Query().Get("after") {
+		case "":
+			_, err = w.Write([]byte(`
+			{
+				"incidents": [
+					{
+						"id": "01FDAG4SAP5TYPT98WGR2N7W91",
+						"reference": "INC-2",
+						"name": "Our database is sad",
+						"status": "triage",
+						"mode": "real",
+						"visibility": "public",
+						"permalink": "https://app.incident.io/incidents/2",
+						"severity": {
+							"id": "01FH5TZRWMNAFB0DZ23FD1TV96",
+							"name": "Minor",
+							"description": "Issues with minor impact.",
+							"rank": 1,
+							"created_at": "2021-08-17T13:28:57.801578Z",
+							"updated_at": "2021-08-17T13:28:57.801578Z"
+						},
+						"custom_field_entries": [
+							{
+								"custom_field": {
+									"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+									"name": "Affected Team",
+									"description": "The team responsible.",
+									"field_type": "single_select",
+									"options": []
+								},
+								"values": [
+									{
+										"value_option": {
+											"id": "01FCNDV6P870EA6S7TK1DSYDG1",
+											"custom_field_id": "01FCNDV6P870EA6S7TK1DSYDG0",
+											"value": "Product",
+											"sort_key": 10
+										}
+									}
+								]
+							}
+						],
+						"incident_role_assignments": [],
+						"timestamps": [
+							{"name": "Reported at", "last_occurred_at": "2021-08-17T13:28:57.801578Z"},
+							{"name": "Resolved at"}
+						],
+						"created_at": "2021-08-17T13:28:57.801578Z",
+						"updated_at": "2021-08-17T13:28:57.801578Z"
+					}
+				],
+				"pagination_meta": {
+					"after": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"page_size": 1,
+					"total_record_count": 2
+				}
+			}`))
+		case "01FDAG4SAP5TYPT98WGR2N7W91":
+			_, err = w.Write([]byte(`
+			{
+				"incidents": [
+					{
+						"id": "01FDAG4SAP5TYPT98WGR2N7W90",
+						"reference": "INC-1",
+						"name": "Our website is down",
+						"status": "closed",
+						"mode": "test",
+						"visibility": "private",
+						"custom_field_entries": [],
+						"incident_role_assignments": [],
+						"created_at": "2021-08-16T13:28:57.801578Z",
+						"updated_at": "2021-08-16T13:28:57.801578Z"
+					}
+				],
+				"pagination_meta": {
+					"page_size": 1,
+					"total_record_count": 2
+				}
+			}`))
+		default:
+			t.Fatalf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	incidents, err := client.Incidents().List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, incidents, 2)
+
+	incident := incidents[0]
+	assert.Equal(t, "INC-2", incident.Reference)
+	assert.Equal(t, incidentio.IncidentStatusTriage, incident.Status)
+	assert.Equal(t, incidentio.IncidentModeReal, incident.Mode)
+	assert.Equal(t, incidentio.IncidentVisibilityPublic, incident.Visibility)
+	assert.Equal(t, "Minor", incident.Severity.Name)
+	require.Len(t, incident.CustomFieldEntries, 1)
+	assert.Equal(t, "Affected Team", incident.CustomFieldEntries[0].CustomField.Name)
+	assert.Equal(t, "Product", incident.CustomFieldEntries[0].Values[0].ValueOption.Value)
+	require.Len(t, incident.Timestamps, 2)
+	assert.NotNil(t, incident.Timestamps[0].LastOccurredAt)
+	assert.Nil(t, incident.Timestamps[1].LastOccurredAt)
+
+	assert.Equal(t, "INC-1", incidents[1].Reference)
+	assert.Nil(t, incidents[1].Severity)
+}
+
+func TestIncidentsListPagesStops(t *testing.T) {
+	requests := 0
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, err := w.Write([]byte(`
+		{
+			"incidents": [{"id": "1", "reference": "INC-1"}],
+			"pagination_meta": {"after": "1", "page_size": 1, "total_record_count": 10}
+		}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	err := client.Incidents().ListPages(context.Background(), nil, func(page []incidentio.Incident) bool {
+		return false
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestIncidentsGetByReference(t *testing.T) {
+	requests := 0
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Equal(t, "/v1/incidents", r.URL.Path)
+		require.Equal(t, "GET", r.Method)
+
+		var err error
+		switch r.URL.Query().Get("after") {
+		case "":
+			_, err = w.Write([]byte(`
+			{
+				"incidents": [{"id": "01FDAG4SAP5TYPT98WGR2N7W93", "reference": "INC-5"}],
+				"pagination_meta": {"after": "01FDAG4SAP5TYPT98WGR2N7W93", "page_size": 1}
+			}`))
+		case "01FDAG4SAP5TYPT98WGR2N7W93":
+			_, err = w.Write([]byte(`
+			{
+				"incidents": [{"id": "01FDAG4SAP5TYPT98WGR2N7W92", "reference": "INC-3"}],
+				"pagination_meta": {"after": "01FDAG4SAP5TYPT98WGR2N7W92", "page_size": 1}
+			}`))
+		case "01FDAG4SAP5TYPT98WGR2N7W92":
+			_, err = w.Write([]byte(`
+			{
+				"incidents": [{"id": "01FDAG4SAP5TYPT98WGR2N7W91", "reference": "INC-1"}],
+				"pagination_meta": {"page_size": 1}
+			}`))
+		default:
+			t.Fatalf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	// The incident is on the second page: the third one isn't requested.
+	incident, err := client.Incidents().GetByReference(context.Background(), "inc-3")
+	require.NoError(t, err)
+	assert.Equal(t, "01FDAG4SAP5TYPT98WGR2N7W92", incident.Id)
+	assert.Equal(t, 2, requests)
+
+	requests = 0
+	_, err = client.Incidents().GetByReference(context.Background(), "INC-4")
+	assert.Error(t, err)
+	assert.Equal(t, 3, requests)
+
+	requests = 0
+	_, err = client.Incidents().GetByReference(context.Background(), "foobar")
+	assert.Error(t, err)
+	assert.Equal(t, 0, requests)
+}
@@ -1,11 +1,13 @@
 package incidentio_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,14 +41,114 @@ func TestIncidentRolesGet(t *testing.T) {
 
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
-	role, err := client.IncidentRoles().Get("01FCNDV6P870EA6S7TK1DSYDG0")
+	role, err := client.IncidentRoles().Get(context.Background(), "01FCNDV6P870EA6S7TK1DSYDG0")
 	require.NoError(t, err)
 
-	assert.Equal(t, "Incident Lead", role.IncidentRole.Name)
-	assert.Equal(t, "The person currently coordinating the incident", role.IncidentRole.Description)
-	assert.Equal(t, true, role.IncidentRole.Required)
-	assert.Equal(t, "Take point on the incident; Make sure people are clear on responsibilities", role.IncidentRole.Instructions)
-	assert.Equal(t, "lead", role.IncidentRole.ShortForm)
+	assert.Equal(t, "Incident Lead", role.Name)
+	assert.Equal(t, "The person currently coordinating the incident", role.Description)
+	assert.Equal(t, true, role.Required)
+	assert.Equal(t, "Take point on the incident; Make sure people are clear on responsibilities", role.Instructions)
+	assert.Equal(t, "lead", role.ShortForm)
+	assert.Equal(t, incidentio.RoleTypeLead, role.RoleType)
+	assert.Equal(t, time.Date(2021, 8, 17, 13, 28, 57, 801578000, time.UTC), role.CreatedAt)
+	assert.Equal(t, time.Date(2021, 8, 17, 13, 28, 57, 801578000, time.UTC), role.UpdatedAt)
+}
+
+const incidentRolesListResponse = `
+{
+	"incident_roles": [
+		{
+			"created_at": "2021-08-17T13:28:57.801578Z",
+			"description": "The person currently coordinating the incident",
+			"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+			"instructions": "Take point on the incident",
+			"name": "Incident Lead",
+			"required": true,
+			"role_type": "lead",
+			"shortform": "lead",
+			"updated_at": "2021-08-17T13:28:57.801578Z"
+		},
+		{
+			"created_at": "2021-08-17T13:28:57.801578Z",
+			"description": "The person who reported the incident",
+			"id": "01FCNDV6P870EA6S7TK1DSYDG1",
+			"instructions": "Provide context on the incident",
+			"name": "Reporter",
+			"required": false,
+			"role_type": "reporter",
+			"shortform": "reporter",
+			"updated_at": "2021-08-17T13:28:57.801578Z"
+		},
+		{
+			"created_at": "2021-08-17T13:28:57.801578Z",
+			"description": "Talks to the outside world",
+			"id": "01FCNDV6P870EA6S7TK1DSYDG2",
+			"instructions": "Keep everyone informed",
+			"name": "Communications Lead",
+			"required": false,
+			"role_type": "custom",
+			"shortform": "comms",
+			"updated_at": "2021-08-17T13:28:57.801578Z"
+		}
+	]
+}
+`
+
+func TestIncidentRolesList(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/incident_roles", r.URL.String())
+		require.Equal(t, "GET", r.Method)
+		_, err := w.Write([]byte(incidentRolesListResponse))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	response, err := client.IncidentRoles().List(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, response, 3)
+	assert.Equal(t, "Incident Lead", response[0].Name)
+	assert.Equal(t, incidentio.RoleTypeReporter, response[1].RoleType)
+	assert.Equal(t, "comms", response[2].ShortForm)
+}
+
+func TestIncidentRolesGetByRoleType(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/incident_roles", r.URL.String())
+		require.Equal(t, "GET", r.Method)
+		_, err := w.Write([]byte(incidentRolesListResponse))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	role, err := client.IncidentRoles().GetByRoleType(context.Background(), incidentio.RoleTypeReporter)
+	require.NoError(t, err)
+	assert.Equal(t, "01FCNDV6P870EA6S7TK1DSYDG1", role.Id)
+	assert.Equal(t, "Reporter", role.Name)
+
+	_, err = client.IncidentRoles().GetByRoleType(context.Background(), incidentio.RoleType("unknown"))
+	assert.Error(t, err)
+}
+
+func TestParseRoleType(t *testing.T) {
+	for _, value := range []string{"lead", "reporter", "custom"} {
+		roleType, err := incidentio.ParseRoleType(value)
+		require.NoError(t, err)
+		assert.Equal(t, value, string(*roleType))
+	}
+
+	_, err := incidentio.ParseRoleType("foo")
+	assert.Error(t, err)
+
+	assert.True(t, incidentio.RoleTypeLead.IsBuiltIn())
+	assert.True(t, incidentio.RoleTypeReporter.IsBuiltIn())
+	assert.False(t, incidentio.RoleTypeCustom.IsBuiltIn())
 }
 
 func TestIncidentRolesCreate(t *testing.T) {
@@ -66,8 +168,6 @@ func TestIncidentRolesCreate(t *testing.T) {
 		roleResp := &incidentio.IncidentRoleResponse{
 			IncidentRole: incidentio.IncidentRoleMetadata{
 				Id:           "id123",
-				CreatedAt:    "",
-				UpdatedAt:    "",
 				RoleType:     "foo",
 				IncidentRole: *role,
 			},
@@ -91,12 +191,12 @@ func TestIncidentRolesCreate(t *testing.T) {
 		ShortForm:    "some short form",
 	}
 
-	response, err := client.IncidentRoles().Create(request)
+	response, err := client.IncidentRoles().Create(context.Background(), request, false)
 	require.NoError(t, err)
 
-	assert.Equal(t, "id123", response.IncidentRole.Id)
-	assert.Equal(t, request.Name, response.IncidentRole.Name)
-	assert.Equal(t, request.Description, response.IncidentRole.Description)
+	assert.Equal(t, "id123", response.Id)
+	assert.Equal(t, request.Name, response.Name)
+	assert.Equal(t, request.Description, response.Description)
 }
 
 func TestIncidentRolesUpdate(t *testing.T) {
@@ -116,8 +216,6 @@ func TestIncidentRolesUpdate(t *testing.T) {
 		roleResp := &incidentio.IncidentRoleResponse{
 			IncidentRole: incidentio.IncidentRoleMetadata{
 				Id:           "id123",
-				CreatedAt:    "",
-				UpdatedAt:    "",
 				RoleType:     "foo",
 				IncidentRole: *role,
 			},
@@ -141,12 +239,12 @@ func TestIncidentRolesUpdate(t *testing.T) {
 		ShortForm:    "some short form",
 	}
 
-	response, err := client.IncidentRoles().Update("id123", request)
+	response, err := client.IncidentRoles().Update(context.Background(), "id123", request)
 	require.NoError(t, err)
 
-	assert.Equal(t, "id123", response.IncidentRole.Id)
-	assert.Equal(t, request.Name, response.IncidentRole.Name)
-	assert.Equal(t, request.Description, response.IncidentRole.Description)
+	assert.Equal(t, "id123", response.Id)
+	assert.Equal(t, request.Name, response.Name)
+	assert.Equal(t, request.Description, response.Description)
 }
 
 func TestIncidentRolesDelete(t *testing.T) {
@@ -169,6 +267,6 @@ func TestIncidentRolesDelete(t *testing.T) {
 
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
-	err := client.IncidentRoles().Delete("id123")
+	err := client.IncidentRoles().Delete(context.Background(), "id123")
 	require.NoError(t, err)
 }
@@ -0,0 +1,476 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Operation is an operation supported by a Service.
+type Operation string
+
+const (
+	OperationList   Operation = "list"
+	OperationGet    Operation = "get"
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// recoveryTimeout bounds the requests made to recover from a creation which
+// failed without telling whether the object has been created or not.
+const recoveryTimeout = 30 * time.Second
+
+// clockSkew is the maximum difference between the local clock and the
+// incident.io one, when comparing the creation date of an object with the
+// time a creation started.
+const clockSkew = time.Minute
+
+// defaultExpectedStatus are the status codes returned by most of the
+// incident.io endpoints on success.
+var defaultExpectedStatus = map[Operation][]int{
+	OperationList:   {http.StatusOK},
+	OperationGet:    {http.StatusOK},
+	OperationCreate: {http.StatusCreated},
+	OperationUpdate: {http.StatusOK},
+	OperationDelete: {http.StatusNoContent},
+}
+
+// Endpoint describes how to query an incident.io API endpoint.
+type Endpoint struct {
+	// Path is the path of the endpoint, relative to the API version.
+	Path string
+
+	// Key is the name of the field wrapping a single object in the responses.
+	Key string
+
+	// ListKey is the name of the field wrapping the objects in the list responses.
+	ListKey string
+
+	// ExpectedStatus overrides, per operation, the status codes returned by
+	// the endpoint on success.
+	ExpectedStatus map[Operation][]int
+
+	// PageSize is the number of objects requested per page, for the
+	// paginated endpoints.
+	PageSize int
+}
+
+func (e Endpoint) expectedStatus(operation Operation) []int {
+	if status, ok := e.ExpectedStatus[operation]; ok {
+		return status
+	}
+
+	return defaultExpectedStatus[operation]
+}
+
+// Service implements the usual operations on an incident.io endpoint.
+//
+// In is the type sent to the API to create or update an object, Out is the
+// type of the objects returned by the API.
+type Service[In any, Out any] struct {
+	client   *Client
+	endpoint Endpoint
+	lookup   *Lookup[In, Out]
+}
+
+// Lookup describes how to find an existing object matching the input used to
+// create it.
+type Lookup[In any, Out any] struct {
+	// Params returns the query parameters used to list the candidate objects.
+	Params func(input In) url.Values
+
+	// Match returns true if the object has been created using the input.
+	Match func(input In, object Out) bool
+
+	// ID returns the identifier of the object.
+	ID func(object Out) string
+
+	// CreatedAt returns when the object has been created, if the endpoint
+	// returns it.
+	CreatedAt func(object Out) time.Time
+}
+
+func NewService[In any, Out any](client *Client, endpoint Endpoint) *Service[In, Out] {
+	return &Service[In, Out]{
+		client:   client,
+		endpoint: endpoint,
+	}
+}
+
+// WithLookup configures how to find objects already created, when a
+// creation fails without telling whether the object has been created or not.
+func (s *Service[In, Out]) WithLookup(lookup Lookup[In, Out]) *Service[In, Out] {
+	s.lookup = &lookup
+	return s
+}
+
+func (s *Service[In, Out]) url(id string) string {
+	if id == "" {
+		return fmt.Sprintf("/v1/%s", s.endpoint.Path)
+	}
+
+	return fmt.Sprintf("/v1/%s/%s", s.endpoint.Path, id)
+}
+
+// List returns all the objects of the endpoint.
+func (s *Service[In, Out]) List(ctx context.Context) ([]Out, error) {
+	return s.ListWithParams(ctx, nil)
+}
+
+// ListWithParams returns the objects of the endpoint matching the query
+// parameters, going through all the pages of paginated endpoints.
+func (s *Service[In, Out]) ListWithParams(ctx context.Context, params url.Values) ([]Out, error) {
+	objects := []Out{}
+
+	err := s.ListPages(ctx, params, func(page []Out) bool {
+		objects = append(objects, page...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// ListPages lists the objects of the endpoint matching the query parameters,
+// calling fn with each page of objects until there are no more pages or fn
+// returns false.
+func (s *Service[In, Out]) ListPages(ctx context.Context, params url.Values, fn func(page []Out) bool) error {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+
+	if s.endpoint.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(s.endpoint.PageSize))
+	}
+
+	for {
+		u := s.url("")
+		if len(query) > 0 {
+			u = u + "?" + query.Encode()
+		}
+
+		body, err := s.client.do(ctx, "GET", u, nil, s.endpoint.expectedStatus(OperationList))
+		if err != nil {
+			return err
+		}
+
+		page, next, err := unwrapPage[Out](body, s.endpoint.ListKey)
+		if err != nil {
+			return err
+		}
+
+		if !fn(page.objects) {
+			return nil
+		}
+
+		if s.endpoint.PageSize == 0 || next == "" || next == query.Get("after") {
+			return nil
+		}
+
+		// Without pagination metadata, a partial page is the last one.
+		if !page.hasMeta && len(page.objects) < s.endpoint.PageSize {
+			return nil
+		}
+
+		query.Set("after", next)
+	}
+}
+
+func (s *Service[In, Out]) Get(ctx context.Context, id string) (*Out, error) {
+	if id == "" {
+		return nil, fmt.Errorf("you must specify an ID to get")
+	}
+
+	body, err := s.client.do(ctx, "GET", s.url(id), nil, s.endpoint.expectedStatus(OperationGet))
+	if err != nil {
+		return nil, err
+	}
+
+	return unwrap[Out](body, s.endpoint.Key)
+}
+
+// Create creates a new object.
+//
+// If the object has been created but the response can't be decoded, the
+// object is read again using the ID found in the response. If this fails too,
+// a *PartiallyCreatedError is returned, containing the ID of the new object.
+//
+// Creations failing without telling whether the object has been created or
+// not (timeouts, server errors, etc.) are recovered if the service has a
+// lookup: see recoverCreate. An object which existed before the creation is
+// only updated with the input if adopt is true.
+func (s *Service[In, Out]) Create(ctx context.Context, input In, adopt bool) (*Out, error) {
+	started := time.Now()
+
+	body, err := s.client.do(ctx, "POST", s.url(""), input, s.endpoint.expectedStatus(OperationCreate))
+	if err != nil && isAmbiguousError(err) && s.lookup != nil {
+		return s.recoverCreate(ctx, input, adopt, started, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := unwrap[Out](body, s.endpoint.Key)
+	if err == nil {
+		return out, nil
+	}
+
+	id := extractID(body, s.endpoint.Key)
+	if id == "" {
+		return nil, err
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("unable to decode the response after creating %s %s, reading it again: %s", s.endpoint.Path, id, err))
+
+	out, getErr := s.Get(ctx, id)
+	if getErr != nil {
+		return nil, &PartiallyCreatedError{ID: id, Err: err}
+	}
+
+	return out, nil
+}
+
+// recoverCreate looks for the object matching the input after the creation
+// started at the specified time failed with an ambiguous error.
+//
+// If the matching object has been created between the start of the creation
+// and now, give or take clockSkew, it is the object which has just been
+// created and it is returned. Otherwise, the object existed before: if adopt
+// is true, it is updated with the input and returned along with an
+// *ExistingObjectError, so that the caller can warn about it. In all the
+// other cases, the creation error is returned.
+//
+// The creation may have failed because the context expired, so the recovery
+// runs on a new context, bounded by recoveryTimeout.
+func (s *Service[In, Out]) recoverCreate(ctx context.Context, input In, adopt bool, started time.Time, createErr error) (*Out, error) {
+	ctx, cancel := context.WithTimeout(withoutCancel{ctx}, recoveryTimeout)
+	defer cancel()
+
+	existing, err := s.FindExisting(ctx, input)
+	if err != nil || existing == nil {
+		return nil, createErr
+	}
+
+	if s.lookup.CreatedAt != nil {
+		createdAt := s.lookup.CreatedAt(*existing)
+		if !createdAt.Before(started.Add(-clockSkew)) && !createdAt.After(time.Now().Add(clockSkew)) {
+			tflog.Warn(ctx, fmt.Sprintf("unable to create %s, but the matching object has just been created and will be used instead: %s", s.endpoint.Path, createErr))
+			return existing, nil
+		}
+	}
+
+	id := s.lookup.ID(*existing)
+
+	if !adopt {
+		return nil, fmt.Errorf("%w, and the existing %s %s matching the input wasn't created by this request", createErr, s.endpoint.Path, id)
+	}
+
+	updated, err := s.Update(ctx, id, input)
+	if err != nil {
+		return nil, fmt.Errorf("%w, and unable to update the existing %s %s: %s", createErr, s.endpoint.Path, id, err)
+	}
+
+	return updated, &ExistingObjectError{ID: id, Err: createErr}
+}
+
+func (s *Service[In, Out]) Update(ctx context.Context, id string, input In) (*Out, error) {
+	if id == "" {
+		return nil, fmt.Errorf("you must specify an ID to update")
+	}
+
+	body, err := s.client.do(ctx, "PUT", s.url(id), input, s.endpoint.expectedStatus(OperationUpdate))
+	if err != nil {
+		return nil, err
+	}
+
+	return unwrap[Out](body, s.endpoint.Key)
+}
+
+func (s *Service[In, Out]) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("you must specify an ID to delete")
+	}
+
+	_, err := s.client.do(ctx, "DELETE", s.url(id), nil, s.endpoint.expectedStatus(OperationDelete))
+	return err
+}
+
+// FindExisting returns the only existing object matching the input, or nil
+// if there is none.
+func (s *Service[In, Out]) FindExisting(ctx context.Context, input In) (*Out, error) {
+	if s.lookup == nil {
+		return nil, fmt.Errorf("unable to look up existing %s", s.endpoint.Path)
+	}
+
+	var params url.Values
+	if s.lookup.Params != nil {
+		params = s.lookup.Params(input)
+	}
+
+	objects, err := s.ListWithParams(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Out
+	for _, object := range objects {
+		if s.lookup.Match(input, object) {
+			found = append(found, object)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &found[0], nil
+	}
+
+	return nil, fmt.Errorf("found %d matching %s instead of 1", len(found), s.endpoint.Path)
+}
+
+// withoutCancel is a context which keeps the values of its parent, but not
+// its deadline nor its cancellation.
+type withoutCancel struct {
+	parent context.Context
+}
+
+func (withoutCancel) Deadline() (deadline time.Time, ok bool) { return }
+func (withoutCancel) Done() <-chan struct{}                   { return nil }
+func (withoutCancel) Err() error                              { return nil }
+func (c withoutCancel) Value(key any) any                     { return c.parent.Value(key) }
+
+// isAmbiguousError returns true if the error doesn't tell whether incident.io
+// processed the request or not, like network errors or server errors.
+func isAmbiguousError(err error) bool {
+	var errorResponse *IncidentIOErrorResponse
+	if errors.As(err, &errorResponse) {
+		return errorResponse.Status >= 500
+	}
+
+	// Errors returned by the HTTP client, like timeouts
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// unwrap decodes the value wrapped in the key field of the JSON body, or the
+// whole body if no key is specified.
+func unwrap[T any](body []byte, key string) (*T, error) {
+	target := new(T)
+
+	if key == "" {
+		if err := json.Unmarshal(body, target); err != nil {
+			return nil, err
+		}
+		return target, nil
+	}
+
+	envelope := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, err
+	}
+
+	raw, ok := envelope[key]
+	if !ok {
+		return nil, fmt.Errorf("unexpected response, %q is missing", key)
+	}
+
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+type listPage[T any] struct {
+	objects []T
+	hasMeta bool
+}
+
+// unwrapPage decodes the list of objects wrapped in the key field of the JSON
+// body, and returns the cursor of the next page: the one given in the
+// pagination metadata if there are some, or the ID of the last object.
+func unwrapPage[T any](body []byte, key string) (*listPage[T], string, error) {
+	envelope := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, "", err
+	}
+
+	raw, ok := envelope[key]
+	if !ok {
+		return nil, "", fmt.Errorf("unexpected response, %q is missing", key)
+	}
+
+	items := []json.RawMessage{}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, "", err
+	}
+
+	result := &listPage[T]{objects: make([]T, len(items))}
+	for i, item := range items {
+		if err := json.Unmarshal(item, &result.objects[i]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if rawMeta, ok := envelope["pagination_meta"]; ok && string(rawMeta) != "null" {
+		meta := PaginationMeta{}
+		if err := json.Unmarshal(rawMeta, &meta); err != nil {
+			return nil, "", err
+		}
+
+		result.hasMeta = true
+		return result, meta.After, nil
+	}
+
+	if len(items) == 0 {
+		return result, "", nil
+	}
+
+	return result, extractID(items[len(items)-1], ""), nil
+}
+
+// PaginationMeta describes the pagination of the list responses.
+type PaginationMeta struct {
+	After            string `json:"after"`
+	PageSize         int64  `json:"page_size"`
+	TotalRecordCount int64  `json:"total_record_count"`
+}
+
+// extractID returns the ID of the object found in the JSON body, or an empty
+// string if there is none.
+func extractID(body []byte, key string) string {
+	var object struct {
+		Id string `json:"id"`
+	}
+
+	if key == "" {
+		_ = json.Unmarshal(body, &object)
+		return object.Id
+	}
+
+	envelope := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return ""
+	}
+
+	raw, ok := envelope[key]
+	if !ok {
+		return ""
+	}
+
+	_ = json.Unmarshal(raw, &object)
+	return object.Id
+}
@@ -0,0 +1,582 @@
+package incidentio_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+type widget struct {
+	Name string `json:"name"`
+}
+
+type widgetMetadata struct {
+	widget
+
+	Id string `json:"id"`
+}
+
+func TestServiceCustomEndpoint(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.URL.Path == "/v1/widgets" {
+				require.Equal(t, "color=blue", r.URL.RawQuery)
+				_, err := w.Write([]byte(`{"things": [{"id": "1", "name": "one"}, {"id": "2", "name": "two"}]}`))
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, "/v1/widgets/1", r.URL.Path)
+			_, err := w.Write([]byte(`{"thing": {"id": "1", "name": "one"}}`))
+			require.NoError(t, err)
+
+		case "POST":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"thing": {"id": "3", "name": "three"}}`))
+			require.NoError(t, err)
+
+		case "DELETE":
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path:    "widgets",
+		Key:     "thing",
+		ListKey: "things",
+		ExpectedStatus: map[incidentio.Operation][]int{
+			incidentio.OperationCreate: {http.StatusOK},
+			incidentio.OperationDelete: {http.StatusOK},
+		},
+	})
+
+	widgets, err := service.ListWithParams(context.Background(), url.Values{"color": {"blue"}})
+	require.NoError(t, err)
+	require.Len(t, widgets, 2)
+	assert.Equal(t, "two", widgets[1].Name)
+
+	w, err := service.Get(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "1", w.Id)
+	assert.Equal(t, "one", w.Name)
+
+	w, err = service.Create(context.Background(), widget{Name: "three"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "3", w.Id)
+
+	err = service.Delete(context.Background(), "1")
+	require.NoError(t, err)
+}
+
+func TestServiceUnexpectedStatus(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"type": "not_found", "status": 404, "errors": []}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	_, err := service.Get(context.Background(), "1")
+	assert.True(t, incidentio.IsErrorStatus(err, 404))
+}
+
+func TestServiceMissingEnvelope(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"other": {"id": "1"}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	_, err := service.Get(context.Background(), "1")
+	assert.EqualError(t, err, `unexpected response, "thing" is missing`)
+}
+
+func TestServiceRequiresID(t *testing.T) {
+	client := incidentio.NewClient("foobar").WithHostURL("http://localhost:0")
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{Path: "widgets"})
+
+	_, err := service.Get(context.Background(), "")
+	assert.Error(t, err)
+
+	_, err = service.Update(context.Background(), "", widget{})
+	assert.Error(t, err)
+
+	err = service.Delete(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestServiceLenientSuccessStatus(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			// Documented as "201 Created"
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"thing": {"id": "1", "name": "one"}}`))
+			require.NoError(t, err)
+		case "DELETE":
+			// Documented as "204 No Content"
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	w, err := service.Create(context.Background(), widget{Name: "one"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "1", w.Id)
+
+	err = service.Delete(context.Background(), "1")
+	require.NoError(t, err)
+}
+
+func TestServiceCreateReadsBackUndecodableResponse(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			_, err := w.Write([]byte(`{"thing": {"id": "1", "name": 42}}`))
+			require.NoError(t, err)
+		case "GET":
+			require.Equal(t, "/v1/widgets/1", r.URL.Path)
+			_, err := w.Write([]byte(`{"thing": {"id": "1", "name": "one"}}`))
+			require.NoError(t, err)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	w, err := service.Create(context.Background(), widget{Name: "one"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "1", w.Id)
+	assert.Equal(t, "one", w.Name)
+}
+
+func TestServiceCreateMissingEnvelope(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method, "the object must not be read again")
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte(`{"new_thing": {"id": "1", "name": "one"}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	_, err := service.Create(context.Background(), widget{Name: "one"}, false)
+	assert.EqualError(t, err, `unexpected response, "thing" is missing`)
+}
+
+func TestServiceCreatePartiallyCreated(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			_, err := w.Write([]byte(`{"thing": {"id": "1", "name": 42}}`))
+			require.NoError(t, err)
+		case "GET":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	_, err := service.Create(context.Background(), widget{Name: "one"}, false)
+	require.Error(t, err)
+
+	var partial *incidentio.PartiallyCreatedError
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, "1", partial.ID)
+}
+
+func TestServiceUndecodableError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, err := w.Write([]byte(`<html>Bad Gateway</html>`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "thing",
+	})
+
+	_, err := service.Get(context.Background(), "1")
+	assert.True(t, incidentio.IsErrorStatus(err, http.StatusBadGateway))
+}
+
+func TestServiceCreateUpdatesExistingAfterAmbiguousFailure(t *testing.T) {
+	var updated []incidentio.CustomFieldOption
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusGatewayTimeout)
+		case "GET":
+			require.Equal(t, "/v1/custom_field_options", r.URL.Path)
+			require.Equal(t, "field1", r.URL.Query().Get("custom_field_id"))
+			_, err := w.Write([]byte(`
+			{
+				"custom_field_options": [
+					{"id": "opt1", "custom_field_id": "field1", "value": "one", "sort_key": 10},
+					{"id": "opt2", "custom_field_id": "field1", "value": "two", "sort_key": 20}
+				]
+			}`))
+			require.NoError(t, err)
+		case "PUT":
+			// Custom field options have no creation date: the matching
+			// option may have existed before, and is only updated when
+			// adopting existing objects.
+			require.Equal(t, "/v1/custom_field_options/opt2", r.URL.Path)
+
+			option := incidentio.CustomFieldOption{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&option))
+			updated = append(updated, option)
+
+			_, err := w.Write([]byte(`{"custom_field_option": {"id": "opt2", "custom_field_id": "field1", "value": "two", "sort_key": 30}}`))
+			require.NoError(t, err)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	input := incidentio.CustomFieldOption{
+		CustomFieldId: "field1",
+		Value:         "two",
+		SortKey:       30,
+	}
+	var existing *incidentio.ExistingObjectError
+
+	option, err := client.CustomFieldOptions().Create(context.Background(), input, false)
+	assert.Nil(t, option)
+	assert.True(t, incidentio.IsErrorStatus(err, http.StatusGatewayTimeout))
+	assert.False(t, errors.As(err, &existing))
+	assert.Empty(t, updated)
+
+	option, err = client.CustomFieldOptions().Create(context.Background(), input, true)
+	require.ErrorAs(t, err, &existing)
+	assert.Equal(t, "opt2", existing.ID)
+	assert.True(t, incidentio.IsErrorStatus(err, http.StatusGatewayTimeout))
+	require.NotNil(t, option)
+	assert.Equal(t, int64(30), option.SortKey)
+	require.Len(t, updated, 1)
+	assert.Equal(t, int64(30), updated[0].SortKey)
+
+	option, err = client.CustomFieldOptions().Create(context.Background(), incidentio.CustomFieldOption{
+		CustomFieldId: "field1",
+		Value:         "three",
+	}, true)
+	assert.Nil(t, option)
+	assert.True(t, incidentio.IsErrorStatus(err, http.StatusGatewayTimeout))
+	assert.False(t, errors.As(err, &existing))
+}
+
+func TestServiceCreateRecoversByCreationDate(t *testing.T) {
+	var createdAt time.Time
+	updates := 0
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		severity := `{"id": "1", "name": "Minor", "rank": 1, "created_at": "` + createdAt.UTC().Format(time.RFC3339) + `"}`
+
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusBadGateway)
+		case "GET":
+			_, err := w.Write([]byte(`{"severities": [` + severity + `]}`))
+			require.NoError(t, err)
+		case "PUT":
+			require.Equal(t, "/v1/severities/1", r.URL.Path)
+			updates++
+			_, err := w.Write([]byte(`{"severity": {"id": "1", "name": "Minor", "rank": 2}}`))
+			require.NoError(t, err)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	input := incidentio.Severity{Name: "Minor", Rank: 2}
+	var existing *incidentio.ExistingObjectError
+
+	// The matching severity has been created by the failed request, even if
+	// the clocks differ a bit: it is used as is.
+	for _, offset := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+		createdAt = time.Now().Add(offset)
+
+		severity, err := client.Severities().Create(context.Background(), input, false)
+		require.NoError(t, err)
+		assert.Equal(t, "1", severity.Id)
+		assert.Equal(t, int64(1), severity.Rank)
+	}
+
+	// The matching severity existed before the creation: it is only updated
+	// when adopting existing objects.
+	for _, offset := range []time.Duration{-time.Hour, time.Hour} {
+		createdAt = time.Now().Add(offset)
+
+		severity, err := client.Severities().Create(context.Background(), input, false)
+		assert.Nil(t, severity)
+		assert.True(t, incidentio.IsErrorStatus(err, http.StatusBadGateway))
+		assert.False(t, errors.As(err, &existing))
+		assert.Equal(t, 0, updates)
+	}
+
+	createdAt = time.Now().Add(-time.Hour)
+
+	severity, err := client.Severities().Create(context.Background(), input, true)
+	require.ErrorAs(t, err, &existing)
+	assert.Equal(t, "1", existing.ID)
+	assert.Equal(t, int64(2), severity.Rank)
+	assert.Equal(t, 1, updates)
+}
+
+func TestServiceCreateRecoversAfterDeadline(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method)
+		mu.Unlock()
+
+		switch r.Method {
+		case "POST":
+			// The severity is created, but the response arrives too late.
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusCreated)
+		case "GET":
+			createdAt := time.Now().UTC().Format(time.RFC3339Nano)
+			_, err := w.Write([]byte(`{"severities": [{"id": "1", "name": "Minor", "rank": 1, "created_at": "` + createdAt + `"}]}`))
+			require.NoError(t, err)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	severity, err := client.Severities().Create(ctx, incidentio.Severity{Name: "Minor", Rank: 1}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "1", severity.Id)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"POST", "GET"}, requests)
+}
+
+func TestServiceCreateDoesntAdoptAfterClientError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method, "no lookup should happen")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, err := w.Write([]byte(`{"type": "validation_error", "status": 422, "errors": []}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	_, err := client.Severities().Create(context.Background(), incidentio.Severity{Name: "Minor"}, false)
+	assert.True(t, incidentio.IsErrorStatus(err, http.StatusUnprocessableEntity))
+}
+
+func TestServiceFindExisting(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`
+		{
+			"severities": [
+				{"id": "1", "name": "Minor", "rank": 1, "created_at": "2021-08-17T13:28:57.801578Z", "updated_at": "2021-08-17T13:28:57.801578Z"},
+				{"id": "2", "name": "Major", "rank": 2, "created_at": "2021-08-17T13:28:57.801578Z", "updated_at": "2021-08-17T13:28:57.801578Z"},
+				{"id": "3", "name": "Major", "rank": 3, "created_at": "2021-08-17T13:28:57.801578Z", "updated_at": "2021-08-17T13:28:57.801578Z"}
+			]
+		}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+
+	severity, err := client.Severities().FindExisting(context.Background(), incidentio.Severity{Name: "Minor"})
+	require.NoError(t, err)
+	assert.Equal(t, "1", severity.Id)
+
+	severity, err = client.Severities().FindExisting(context.Background(), incidentio.Severity{Name: "Critical"})
+	require.NoError(t, err)
+	assert.Nil(t, severity)
+
+	_, err = client.Severities().FindExisting(context.Background(), incidentio.Severity{Name: "Major"})
+	assert.Error(t, err)
+}
+
+func TestServiceListPaginatesWithoutMetadata(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "2", r.URL.Query().Get("page_size"))
+
+		var err error
+		switch r.URL.Query().Get("after") {
+		case "":
+			_, err = w.Write([]byte(`{"things": [{"id": "1"}, {"id": "2"}]}`))
+		case "2":
+			_, err = w.Write([]byte(`{"things": [{"id": "3"}]}`))
+		default:
+			t.Fatalf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path:     "widgets",
+		ListKey:  "things",
+		PageSize: 2,
+	})
+
+	widgets, err := service.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, widgets, 3)
+	assert.Equal(t, "3", widgets[2].Id)
+}
+
+func TestServiceReadOnly(t *testing.T) {
+	var methods []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, err := w.Write([]byte(`{"widgets": [{"id": "1", "name": "one"}]}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL).WithReadOnly(true)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path:    "widgets",
+		Key:     "widget",
+		ListKey: "widgets",
+	})
+
+	widgets, err := service.List(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, widgets, 1)
+
+	_, err = service.Create(context.Background(), widget{Name: "two"}, false)
+	assert.ErrorIs(t, err, incidentio.ErrReadOnly)
+
+	_, err = service.Update(context.Background(), "1", widget{Name: "two"})
+	assert.ErrorIs(t, err, incidentio.ErrReadOnly)
+
+	err = service.Delete(context.Background(), "1")
+	assert.ErrorIs(t, err, incidentio.ErrReadOnly)
+
+	assert.Equal(t, []string{"GET"}, methods)
+}
+
+func TestServiceContextDeadline(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "widget",
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := service.Get(ctx, "1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServiceUserAgent(t *testing.T) {
+	var userAgent string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		_, err := w.Write([]byte(`{"widget": {"id": "1", "name": "one"}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	service := incidentio.NewService[widget, widgetMetadata](client, incidentio.Endpoint{
+		Path: "widgets",
+		Key:  "widget",
+	})
+
+	_, err := service.Get(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, incidentio.DefaultUserAgent, userAgent)
+
+	client.WithUserAgent("terraform-provider-incidentio/1.2.3").AppendUserAgent(" my-tool/0.1 ").AppendUserAgent("")
+
+	_, err = service.Get(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "terraform-provider-incidentio/1.2.3 my-tool/0.1", userAgent)
+	assert.Equal(t, userAgent, client.UserAgent())
+}
@@ -1,5 +1,10 @@
 package incidentio
 
+import (
+	"net/http"
+	"time"
+)
+
 type Severity struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -9,9 +14,9 @@ type Severity struct {
 type SeverityMetadata struct {
 	Severity
 
-	Id        string `json:"id"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	Id        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type SeverityResponse struct {
@@ -20,47 +25,29 @@ type SeverityResponse struct {
 
 // Severities is used to query severities
 type Severities struct {
-	client  *Client
-	urlPart string
+	*Service[Severity, SeverityMetadata]
 }
 
 func (c *Client) Severities() *Severities {
 	return &Severities{
-		client:  c,
-		urlPart: "severities",
-	}
-}
-
-func (i *Severities) Get(id string) (*SeverityResponse, error) {
-	response := SeverityResponse{}
-
-	if err := i.client.get(i.urlPart, id, &response); err != nil {
-		return nil, err
-	}
-
-	return &response, nil
-}
-
-func (i *Severities) Create(severity Severity) (*SeverityResponse, error) {
-	response := &SeverityResponse{}
-
-	if err := i.client.create(i.urlPart, severity, &response); err != nil {
-		return nil, err
+		NewService[Severity, SeverityMetadata](c, Endpoint{
+			Path:    "severities",
+			Key:     "severity",
+			ListKey: "severities",
+			ExpectedStatus: map[Operation][]int{
+				// Severities are deleted asynchronously
+				OperationDelete: {http.StatusAccepted},
+			},
+		}).WithLookup(Lookup[Severity, SeverityMetadata]{
+			Match: func(severity Severity, existing SeverityMetadata) bool {
+				return severity.Name == existing.Name
+			},
+			ID: func(existing SeverityMetadata) string {
+				return existing.Id
+			},
+			CreatedAt: func(existing SeverityMetadata) time.Time {
+				return existing.CreatedAt
+			},
+		}),
 	}
-
-	return response, nil
-}
-
-func (i *Severities) Update(id string, severity Severity) (*SeverityResponse, error) {
-	response := &SeverityResponse{}
-
-	if err := i.client.update(i.urlPart, id, severity, &response); err != nil {
-		return nil, err
-	}
-
-	return response, nil
-}
-
-func (i *Severities) Delete(id string) error {
-	return i.client.delete(i.urlPart, id)
 }
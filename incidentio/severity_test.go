@@ -1,11 +1,13 @@
 package incidentio_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,12 +39,14 @@ func TestSeveritiesGet(t *testing.T) {
 
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
-	response, err := client.Severities().Get("01FCNDV6P870EA6S7TK1DSYDG0")
+	response, err := client.Severities().Get(context.Background(), "01FCNDV6P870EA6S7TK1DSYDG0")
 	require.NoError(t, err)
 
-	assert.Equal(t, "Minor", response.Severity.Name)
-	assert.Equal(t, "It's not really that bad, everyone chill", response.Severity.Description)
-	assert.Equal(t, int64(1), response.Severity.Rank)
+	assert.Equal(t, "Minor", response.Name)
+	assert.Equal(t, "It's not really that bad, everyone chill", response.Description)
+	assert.Equal(t, int64(1), response.Rank)
+	assert.Equal(t, time.Date(2021, 8, 17, 13, 28, 57, 801578000, time.UTC), response.CreatedAt)
+	assert.Equal(t, time.Date(2021, 8, 17, 13, 28, 57, 801578000, time.UTC), response.UpdatedAt)
 }
 
 func TestSeveritiesCreate(t *testing.T) {
@@ -61,10 +65,8 @@ func TestSeveritiesCreate(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 		response := &incidentio.SeverityResponse{
 			Severity: incidentio.SeverityMetadata{
-				Id:        "id123",
-				CreatedAt: "",
-				UpdatedAt: "",
-				Severity:  *severity,
+				Id:       "id123",
+				Severity: *severity,
 			},
 		}
 		body, err = json.Marshal(response)
@@ -84,12 +86,12 @@ func TestSeveritiesCreate(t *testing.T) {
 		Rank:        42,
 	}
 
-	response, err := client.Severities().Create(request)
+	response, err := client.Severities().Create(context.Background(), request, false)
 	require.NoError(t, err)
 
-	assert.Equal(t, "id123", response.Severity.Id)
-	assert.Equal(t, request.Name, response.Severity.Name)
-	assert.Equal(t, request.Description, response.Severity.Description)
+	assert.Equal(t, "id123", response.Id)
+	assert.Equal(t, request.Name, response.Name)
+	assert.Equal(t, request.Description, response.Description)
 }
 
 func TestSeveritiesUpdate(t *testing.T) {
@@ -108,10 +110,8 @@ func TestSeveritiesUpdate(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		response := &incidentio.SeverityResponse{
 			Severity: incidentio.SeverityMetadata{
-				Id:        "id123",
-				CreatedAt: "",
-				UpdatedAt: "",
-				Severity:  *severity,
+				Id:       "id123",
+				Severity: *severity,
 			},
 		}
 		body, err = json.Marshal(response)
@@ -131,13 +131,13 @@ func TestSeveritiesUpdate(t *testing.T) {
 		Rank:        64,
 	}
 
-	response, err := client.Severities().Update("id123", request)
+	response, err := client.Severities().Update(context.Background(), "id123", request)
 	require.NoError(t, err)
 
-	assert.Equal(t, "id123", response.Severity.Id)
-	assert.Equal(t, request.Name, response.Severity.Name)
-	assert.Equal(t, request.Description, response.Severity.Description)
-	assert.Equal(t, int64(64), response.Severity.Rank)
+	assert.Equal(t, "id123", response.Id)
+	assert.Equal(t, request.Name, response.Name)
+	assert.Equal(t, request.Description, response.Description)
+	assert.Equal(t, int64(64), response.Rank)
 }
 
 func TestSeveritiesDelete(t *testing.T) {
@@ -160,6 +160,6 @@ func TestSeveritiesDelete(t *testing.T) {
 
 	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
 
-	err := client.Severities().Delete("id123")
+	err := client.Severities().Delete(context.Background(), "id123")
 	require.NoError(t, err)
 }
@@ -0,0 +1,27 @@
+package incidentio
+
+type UserRole string
+
+const (
+	UserRoleViewer        UserRole = "viewer"
+	UserRoleResponder     UserRole = "responder"
+	UserRoleAdministrator UserRole = "administrator"
+	UserRoleOwner         UserRole = "owner"
+)
+
+// UserRoles returns all the valid user roles.
+func UserRoles() []UserRole {
+	return []UserRole{UserRoleViewer, UserRoleResponder, UserRoleAdministrator, UserRoleOwner}
+}
+
+func ParseUserRole(s string) (*UserRole, error) {
+	return parseEnum(s, UserRoles(), "user role")
+}
+
+type User struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Email       string   `json:"email"`
+	Role        UserRole `json:"role"`
+	SlackUserId string   `json:"slack_user_id"`
+}
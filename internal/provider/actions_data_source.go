@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ActionsDataSource{}
+
+// externalIssueReferenceAttrTypes are the attributes of the external issue
+// reference of an action.
+var externalIssueReferenceAttrTypes = map[string]attr.Type{
+	"provider":        types.StringType,
+	"issue_name":      types.StringType,
+	"issue_permalink": types.StringType,
+}
+
+// actionObjectType is the type of each action returned by the
+// incidentio_actions data source.
+var actionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                       types.StringType,
+		"incident_id":              types.StringType,
+		"description":              types.StringType,
+		"status":                   types.StringType,
+		"follow_up":                types.BoolType,
+		"external_issue_reference": types.ObjectType{AttrTypes: externalIssueReferenceAttrTypes},
+		"completed_at":             types.StringType,
+		"created_at":               types.StringType,
+		"updated_at":               types.StringType,
+	},
+}
+
+type actionObject struct {
+	Id                     types.String `tfsdk:"id"`
+	IncidentId             types.String `tfsdk:"incident_id"`
+	Description            types.String `tfsdk:"description"`
+	Status                 types.String `tfsdk:"status"`
+	FollowUp               types.Bool   `tfsdk:"follow_up"`
+	ExternalIssueReference types.Object `tfsdk:"external_issue_reference"`
+	CompletedAt            types.String `tfsdk:"completed_at"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+}
+
+// fromAction updates the object using the action returned by incident.io.
+func (d *actionObject) fromAction(action incidentio.Action) {
+	d.Id = types.StringValue(action.Id)
+	d.IncidentId = types.StringValue(action.IncidentId)
+	d.Description = types.StringValue(action.Description)
+	d.Status = types.StringValue(string(action.Status))
+	d.FollowUp = types.BoolValue(action.FollowUp)
+	d.CreatedAt = timeValue(action.CreatedAt)
+	d.UpdatedAt = timeValue(action.UpdatedAt)
+
+	d.CompletedAt = types.StringNull()
+	if action.CompletedAt != nil {
+		d.CompletedAt = timeValue(*action.CompletedAt)
+	}
+
+	d.ExternalIssueReference = types.ObjectNull(externalIssueReferenceAttrTypes)
+	if reference := action.ExternalIssueReference; reference != nil {
+		d.ExternalIssueReference = types.ObjectValueMust(externalIssueReferenceAttrTypes, map[string]attr.Value{
+			"provider":        types.StringValue(string(reference.Provider)),
+			"issue_name":      types.StringValue(reference.IssueName),
+			"issue_permalink": types.StringValue(reference.IssuePermalink),
+		})
+	}
+}
+
+type actionsDataSourceData struct {
+	Id                   types.String `tfsdk:"id"`
+	IncidentId           types.String `tfsdk:"incident_id"`
+	IsFollowUp           types.Bool   `tfsdk:"is_follow_up"`
+	ExcludeTestIncidents types.Bool   `tfsdk:"exclude_test_incidents"`
+	IncidentMode         types.String `tfsdk:"incident_mode"`
+	Actions              types.List   `tfsdk:"actions"`
+}
+
+// params returns the query parameters used to list the actions selected by
+// the filters of the data source.
+func (d *actionsDataSourceData) params() url.Values {
+	params := url.Values{}
+
+	if !d.IncidentId.IsNull() {
+		params.Set("incident_id", d.IncidentId.ValueString())
+	}
+
+	if !d.IsFollowUp.IsNull() {
+		params.Set("is_follow_up", strconv.FormatBool(d.IsFollowUp.ValueBool()))
+	}
+
+	if !d.ExcludeTestIncidents.IsNull() {
+		params.Set("exclude_test_incidents", strconv.FormatBool(d.ExcludeTestIncidents.ValueBool()))
+	}
+
+	if !d.IncidentMode.IsNull() {
+		params.Set("incident_mode", d.IncidentMode.ValueString())
+	}
+
+	return params
+}
+
+type ActionsDataSource struct {
+	client *providerClient
+}
+
+func NewActionsDataSource() datasource.DataSource {
+	return &ActionsDataSource{}
+}
+
+func (d *ActionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions"
+}
+
+func (d *ActionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the actions of the incidents, optionally filtered by incident, follow-up or incident mode",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data source",
+				Computed:            true,
+			},
+			"incident_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the actions of this incident",
+				Optional:            true,
+			},
+			"is_follow_up": schema.BoolAttribute{
+				MarkdownDescription: "Only return the actions which are, or are not, follow-up actions",
+				Optional:            true,
+			},
+			"exclude_test_incidents": schema.BoolAttribute{
+				MarkdownDescription: "Don't return the actions of the test incidents. This is deprecated by incident.io in favour of `incident_mode`.",
+				Optional:            true,
+				DeprecationMessage:  "Use incident_mode instead.",
+			},
+			"incident_mode": schema.StringAttribute{
+				MarkdownDescription: "Only return the actions of the incidents of this mode. Must be one of `real`, `test` or `tutorial`. " +
+					"incident.io only returns the actions of the `real` incidents when this is not set.",
+				Optional: true,
+				Validators: []validator.String{
+					isValidIncidentMode(),
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching actions",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the action",
+							Computed:            true,
+						},
+						"incident_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the incident the action belongs to",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the action",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the action, one of `outstanding`, `completed`, `deleted` or `not_doing`",
+							Computed:            true,
+						},
+						"follow_up": schema.BoolAttribute{
+							MarkdownDescription: "Whether the action is a follow-up action",
+							Computed:            true,
+						},
+						"external_issue_reference": schema.SingleNestedAttribute{
+							MarkdownDescription: "The issue tracking the action in an external issue tracker, if any",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"provider": schema.StringAttribute{
+									MarkdownDescription: "The issue tracker, one of `linear`, `jira`, `jira_server`, `github` or `clubhouse`",
+									Computed:            true,
+								},
+								"issue_name": schema.StringAttribute{
+									MarkdownDescription: "Human readable ID of the issue",
+									Computed:            true,
+								},
+								"issue_permalink": schema.StringAttribute{
+									MarkdownDescription: "Link to the issue in the issue tracker",
+									Computed:            true,
+								},
+							},
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "When the action was completed, in RFC3339 format",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the action was created, in RFC3339 format",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the action was last updated, in RFC3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ActionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data actionsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	response, err := d.client.Actions().List(ctx, data.params())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list actions, got error: %s", err))
+		return
+	}
+
+	actions := make([]actionObject, len(response))
+	for i, action := range response {
+		actions[i].fromAction(action)
+	}
+
+	data.Id = types.StringValue("actions")
+
+	data.Actions, diags = types.ListValueFrom(ctx, actionObjectType, actions)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestActionsDataSourceParams(t *testing.T) {
+	data := actionsDataSourceData{
+		IncidentId:           types.StringNull(),
+		IsFollowUp:           types.BoolNull(),
+		ExcludeTestIncidents: types.BoolNull(),
+		IncidentMode:         types.StringNull(),
+	}
+	assert.Equal(t, url.Values{}, data.params())
+
+	data.IncidentId = types.StringValue("01FDAG4SAP5TYPT98WGR2N7W91")
+	data.IsFollowUp = types.BoolValue(false)
+	data.IncidentMode = types.StringValue("test")
+	assert.Equal(t, url.Values{
+		"incident_id":   {"01FDAG4SAP5TYPT98WGR2N7W91"},
+		"is_follow_up":  {"false"},
+		"incident_mode": {"test"},
+	}, data.params())
+}
+
+func TestActionObjectType(t *testing.T) {
+	completedAt := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	var completed, outstanding actionObject
+	completed.fromAction(incidentio.Action{
+		Id:          "1",
+		Status:      incidentio.ActionStatusCompleted,
+		CompletedAt: &completedAt,
+		ExternalIssueReference: &incidentio.ExternalIssueReference{
+			Provider:  incidentio.ExternalIssueProviderGitHub,
+			IssueName: "#123",
+		},
+	})
+	outstanding.fromAction(incidentio.Action{Id: "2", Status: incidentio.ActionStatusOutstanding})
+
+	assert.Equal(t, "2023-02-01T10:00:00Z", completed.CompletedAt.ValueString())
+	assert.Equal(t, types.StringValue("github"), completed.ExternalIssueReference.Attributes()["provider"])
+	assert.True(t, outstanding.CompletedAt.IsNull())
+	assert.True(t, outstanding.ExternalIssueReference.IsNull())
+
+	list, diags := types.ListValueFrom(context.Background(), actionObjectType, []actionObject{completed, outstanding})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, list.Elements(), 2)
+}
+
+func TestAccActionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	data "incidentio_actions" "follow_ups" {
+		is_follow_up = true
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.incidentio_actions.follow_ups", "id", "actions"),
+					resource.TestCheckResourceAttrSet("data.incidentio_actions.follow_ups", "actions.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// createOrAdopt creates a new object from the input or, if adopt is true and
+// an object matching the input already exists, updates the existing object
+// with the input instead.
+//
+// If the creation fails but incident.io updated an existing object instead,
+// the updated object is returned and a warning is added to diags.
+//
+// The returned boolean is true if an existing object has been adopted.
+func createOrAdopt[In any, Out any](ctx context.Context, diags *diag.Diagnostics, service *incidentio.Service[In, Out], adopt bool, input In, id func(Out) string) (*Out, bool, error) {
+	if adopt {
+		existing, err := service.FindExisting(ctx, input)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to look up an existing object to adopt: %w", err)
+		}
+
+		if existing != nil {
+			tflog.Info(ctx, fmt.Sprintf("adopting the existing object with ID=%s", id(*existing)))
+			response, err := service.Update(ctx, id(*existing), input)
+			return response, true, err
+		}
+	}
+
+	response, err := service.Create(ctx, input, adopt)
+
+	var existing *incidentio.ExistingObjectError
+	if errors.As(err, &existing) {
+		diags.AddWarning(
+			"Existing Object Updated",
+			fmt.Sprintf("The object may have been created by a previous attempt, or outside of Terraform, and has been updated with the configuration: %s", existing),
+		)
+		return response, true, nil
+	}
+
+	return response, false, err
+}
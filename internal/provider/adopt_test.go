@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestShouldAdoptExisting(t *testing.T) {
+	client := &providerClient{adoptExisting: true}
+	assert.True(t, client.shouldAdoptExisting(types.BoolNull()))
+	assert.False(t, client.shouldAdoptExisting(types.BoolValue(false)))
+
+	client = &providerClient{adoptExisting: false}
+	assert.False(t, client.shouldAdoptExisting(types.BoolNull()))
+	assert.True(t, client.shouldAdoptExisting(types.BoolValue(true)))
+}
+
+func TestCreateOrAdopt(t *testing.T) {
+	var requests []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		var err error
+		switch r.Method {
+		case "GET":
+			_, err = w.Write([]byte(`{"severities": [{"id": "existing", "name": "Minor", "rank": 1}]}`))
+		case "PUT":
+			_, err = w.Write([]byte(`{"severity": {"id": "existing", "name": "Minor", "rank": 5}}`))
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write([]byte(`{"severity": {"id": "new", "name": "Major", "rank": 2}}`))
+		}
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	service := incidentio.NewClient("foobar").WithHostURL(server.URL).Severities().Service
+	id := func(severity incidentio.SeverityMetadata) string { return severity.Id }
+
+	severity, adopted, err := createOrAdopt(ctx, &diags, service, true, incidentio.Severity{Name: "Minor", Rank: 5}, id)
+	require.NoError(t, err)
+	assert.True(t, adopted)
+	assert.Equal(t, "existing", severity.Id)
+	assert.Equal(t, []string{"GET /v1/severities", "PUT /v1/severities/existing"}, requests)
+
+	requests = nil
+	severity, adopted, err = createOrAdopt(ctx, &diags, service, true, incidentio.Severity{Name: "Major", Rank: 2}, id)
+	require.NoError(t, err)
+	assert.False(t, adopted)
+	assert.Equal(t, "new", severity.Id)
+	assert.Equal(t, []string{"GET /v1/severities", "POST /v1/severities"}, requests)
+
+	requests = nil
+	severity, adopted, err = createOrAdopt(ctx, &diags, service, false, incidentio.Severity{Name: "Minor", Rank: 5}, id)
+	require.NoError(t, err)
+	assert.False(t, adopted)
+	assert.Equal(t, "new", severity.Id)
+	assert.Equal(t, []string{"POST /v1/severities"}, requests)
+	assert.Empty(t, diags)
+}
+
+func TestCreateOrAdoptWarnsWhenUpdatingAfterFailure(t *testing.T) {
+	posted := false
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.Method {
+		case "POST":
+			// The severity is created concurrently, outside of Terraform.
+			posted = true
+			w.WriteHeader(http.StatusBadGateway)
+		case "GET":
+			if posted {
+				_, err = w.Write([]byte(`{"severities": [{"id": "existing", "name": "Minor", "rank": 1, "created_at": "2021-08-17T13:28:57Z"}]}`))
+			} else {
+				_, err = w.Write([]byte(`{"severities": []}`))
+			}
+		case "PUT":
+			_, err = w.Write([]byte(`{"severity": {"id": "existing", "name": "Minor", "rank": 5}}`))
+		}
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	var diags diag.Diagnostics
+	service := incidentio.NewClient("foobar").WithHostURL(server.URL).Severities().Service
+	id := func(severity incidentio.SeverityMetadata) string { return severity.Id }
+
+	_, _, err := createOrAdopt(context.Background(), &diags, service, false, incidentio.Severity{Name: "Minor", Rank: 5}, id)
+	assert.True(t, incidentio.IsErrorStatus(err, http.StatusBadGateway))
+	assert.Empty(t, diags)
+
+	posted = false
+	severity, adopted, err := createOrAdopt(context.Background(), &diags, service, true, incidentio.Severity{Name: "Minor", Rank: 5}, id)
+	require.NoError(t, err)
+	assert.True(t, adopted)
+	assert.Equal(t, "existing", severity.Id)
+	assert.Equal(t, 1, diags.WarningsCount())
+	assert.False(t, diags.HasError())
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resolveApiKey returns the API key configured with exactly one of the
+// api_key, api_key_file or api_key_command attributes, or with the
+// INCIDENT_IO_API_KEY environment variable if none of them is set.
+func resolveApiKey(ctx context.Context, data providerData) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sources := map[string]types.String{
+		"api_key":         data.ApiKey,
+		"api_key_file":    data.ApiKeyFile,
+		"api_key_command": data.ApiKeyCommand,
+	}
+
+	var configured []string
+	for _, name := range []string{"api_key", "api_key_file", "api_key_command"} {
+		value := sources[name]
+		if value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Unknown API Key",
+				fmt.Sprintf("The %s attribute must be known when configuring the provider.", name),
+			)
+			return "", diags
+		}
+
+		if !value.IsNull() {
+			configured = append(configured, name)
+		}
+	}
+
+	if len(configured) > 1 {
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Conflicting API Keys",
+			fmt.Sprintf("Only one of api_key, api_key_file or api_key_command can be set, got: %s.", strings.Join(configured, ", ")),
+		)
+		return "", diags
+	}
+
+	var apiKey string
+	source := "the INCIDENT_IO_API_KEY environment variable"
+
+	if len(configured) == 0 {
+		apiKey = os.Getenv("INCIDENT_IO_API_KEY")
+	} else {
+		var err error
+
+		name := configured[0]
+		value := sources[name].ValueString()
+
+		switch name {
+		case "api_key":
+			apiKey = value
+		case "api_key_file":
+			apiKey, err = readApiKeyFile(value)
+		case "api_key_command":
+			apiKey, err = runApiKeyCommand(ctx, value)
+		}
+
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Unable to Read API Key", err.Error())
+			return "", diags
+		}
+
+		source = fmt.Sprintf("the %s attribute", name)
+	}
+
+	apiKey = strings.TrimSpace(apiKey)
+	if apiKey == "" {
+		diags.AddError(
+			"Missing API Key",
+			fmt.Sprintf("The API key set with %s is empty. "+
+				"Set one of the api_key, api_key_file or api_key_command attributes, or the INCIDENT_IO_API_KEY environment variable.", source),
+		)
+		return "", diags
+	}
+
+	return apiKey, diags
+}
+
+// readApiKeyFile returns the content of the file containing the API key.
+func readApiKeyFile(name string) (string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the API key file: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// runApiKeyCommand runs the command in a shell and returns its output, which
+// contains the API key.
+//
+// The command is killed when the context is done. The processes it started
+// may keep its output open, so this doesn't wait for them to exit.
+func runApiKeyCommand(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return "", fmt.Errorf("unable to run the API key command: %w", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", fmt.Errorf("unable to run the API key command: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+	case <-ctx.Done():
+		_ = cmd.Process.Kill()
+		return "", fmt.Errorf("the API key command didn't finish in time: %w", ctx.Err())
+	}
+
+	return stdout.String(), nil
+}
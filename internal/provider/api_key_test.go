@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveApiKey(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("INCIDENT_IO_API_KEY", " from-env\n")
+
+	keyFile := filepath.Join(t.TempDir(), "api_key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("from-file\n"), 0o600))
+
+	data := providerData{
+		ApiKey:        types.StringNull(),
+		ApiKeyFile:    types.StringNull(),
+		ApiKeyCommand: types.StringNull(),
+	}
+
+	apiKey, diags := resolveApiKey(ctx, data)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "from-env", apiKey)
+
+	withKey := data
+	withKey.ApiKey = types.StringValue("from-config")
+	apiKey, diags = resolveApiKey(ctx, withKey)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "from-config", apiKey)
+
+	withFile := data
+	withFile.ApiKeyFile = types.StringValue(keyFile)
+	apiKey, diags = resolveApiKey(ctx, withFile)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "from-file", apiKey)
+
+	withCommand := data
+	withCommand.ApiKeyCommand = types.StringValue("echo '  from-command  '")
+	apiKey, diags = resolveApiKey(ctx, withCommand)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "from-command", apiKey)
+}
+
+func TestResolveApiKeyErrors(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("INCIDENT_IO_API_KEY", "")
+
+	data := providerData{
+		ApiKey:        types.StringNull(),
+		ApiKeyFile:    types.StringNull(),
+		ApiKeyCommand: types.StringNull(),
+	}
+
+	_, diags := resolveApiKey(ctx, data)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Missing API Key", diags[0].Summary())
+
+	conflicting := data
+	conflicting.ApiKey = types.StringValue("from-config")
+	conflicting.ApiKeyCommand = types.StringValue("echo from-command")
+	_, diags = resolveApiKey(ctx, conflicting)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Conflicting API Keys", diags[0].Summary())
+
+	missingFile := data
+	missingFile.ApiKeyFile = types.StringValue(filepath.Join(t.TempDir(), "missing"))
+	_, diags = resolveApiKey(ctx, missingFile)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unable to Read API Key", diags[0].Summary())
+
+	failingCommand := data
+	failingCommand.ApiKeyCommand = types.StringValue("echo oops >&2; exit 1")
+	_, diags = resolveApiKey(ctx, failingCommand)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "oops")
+
+	emptyCommand := data
+	emptyCommand.ApiKeyCommand = types.StringValue("echo")
+	_, diags = resolveApiKey(ctx, emptyCommand)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Missing API Key", diags[0].Summary())
+}
+
+func TestRunApiKeyCommandTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the command uses sh")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	_, err := runApiKeyCommand(ctx, "sleep 10")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(started), 5*time.Second)
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// computedOnlyAttributes are the attributes set by incident.io only, which
+// can't be configured and so are never checked against the plan.
+var computedOnlyAttributes = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// setAppliedState saves the values returned by incident.io after a create or
+// an update in the state, then reports the values which are inconsistent
+// with the plan.
+//
+// The state is saved on purpose even if the result is inconsistent: the
+// object exists in incident.io and must stay tracked. After a create,
+// Terraform then marks the resource as tainted because of the error, so that
+// it is replaced once the configuration has been fixed.
+//
+// An adopted object existed before and must not be replaced: the
+// inconsistent values are only reported as warnings, see keepPlannedValues.
+func setAppliedState(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics, planned any, applied any, adopted bool) {
+	inconsistent := checkConsistentResult(planned, applied)
+	if adopted {
+		inconsistent = keepPlannedValues(planned, applied, inconsistent)
+	}
+
+	diags.Append(state.Set(ctx, applied)...)
+	diags.Append(inconsistent...)
+}
+
+// keepPlannedValues replaces the inconsistent values of applied, reported by
+// checkConsistentResult, with the planned values, and reports them as
+// warnings instead.
+//
+// Terraform rejects the values which differ from the plan, so the planned
+// values are saved instead: the next refresh reads the values from
+// incident.io again, and the next plan shows the difference.
+func keepPlannedValues(planned any, applied any, inconsistent diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plannedValue := reflect.Indirect(reflect.ValueOf(planned))
+	appliedValue := reflect.Indirect(reflect.ValueOf(applied))
+
+	fields := map[string]int{}
+	for i := 0; i < plannedValue.NumField(); i++ {
+		fields[plannedValue.Type().Field(i).Tag.Get("tfsdk")] = i
+	}
+
+	for _, d := range inconsistent {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			diags.Append(d)
+			continue
+		}
+
+		i := fields[withPath.Path().String()]
+		plannedAttr := plannedValue.Field(i).Interface()
+		appliedAttr := appliedValue.Field(i).Interface()
+		appliedValue.Field(i).Set(plannedValue.Field(i))
+
+		diags.AddAttributeWarning(
+			withPath.Path(),
+			"Adopted object differs from the configuration",
+			fmt.Sprintf(
+				"The existing object has been adopted, but incident.io returned %s for %q instead of the configured value %s. "+
+					"The object is kept, and the difference will be shown by the next plan.",
+				appliedAttr, withPath.Path(), plannedAttr,
+			),
+		)
+	}
+
+	return diags
+}
+
+// checkConsistentResult compares the values planned by Terraform with the
+// values returned by incident.io after a create or update.
+//
+// Both planned and applied must be pointers to the same resource data struct,
+// whose fields are attr.Value tagged with `tfsdk`. The computed only
+// attributes, and the planned values which are unknown or null, are not
+// checked as they are set by incident.io.
+func checkConsistentResult(planned any, applied any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plannedValue := reflect.Indirect(reflect.ValueOf(planned))
+	appliedValue := reflect.Indirect(reflect.ValueOf(applied))
+
+	if plannedValue.Type() != appliedValue.Type() {
+		diags.AddError(
+			"Provider Error",
+			fmt.Sprintf("Unable to compare %T with %T. Please report this issue to the provider developers.", planned, applied),
+		)
+		return diags
+	}
+
+	for i := 0; i < plannedValue.NumField(); i++ {
+		tag := plannedValue.Type().Field(i).Tag.Get("tfsdk")
+		if tag == "" || tag == "-" || computedOnlyAttributes[tag] {
+			continue
+		}
+
+		plannedAttr, ok := plannedValue.Field(i).Interface().(attr.Value)
+		if !ok {
+			continue
+		}
+
+		if plannedAttr.IsUnknown() || plannedAttr.IsNull() {
+			continue
+		}
+
+		appliedAttr := appliedValue.Field(i).Interface().(attr.Value)
+		if plannedAttr.Equal(appliedAttr) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(tag),
+			"Provider produced inconsistent result",
+			fmt.Sprintf(
+				"After applying this change, incident.io returned %s for %q instead of the configured value %s. "+
+					"The value has most likely been normalized by incident.io: update your configuration to match it.",
+				appliedAttr, tag, plannedAttr,
+			),
+		)
+	}
+
+	return diags
+}
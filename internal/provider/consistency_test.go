@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConsistentResult(t *testing.T) {
+	planned := severityData{
+		Id:          types.StringUnknown(),
+		Name:        types.StringValue("Major"),
+		Description: types.StringValue("Something is broken  "),
+		Rank:        types.Int64Value(2),
+	}
+
+	applied := severityData{
+		Id:          types.StringValue("01FCNDV6P870EA6S7TK1DSYDG0"),
+		Name:        types.StringValue("Major"),
+		Description: types.StringValue("Something is broken  "),
+		Rank:        types.Int64Value(2),
+	}
+
+	diags := checkConsistentResult(&planned, &applied)
+	assert.False(t, diags.HasError())
+
+	applied.Description = types.StringValue("Something is broken")
+
+	diags = checkConsistentResult(&planned, &applied)
+	require.True(t, diags.HasError())
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Provider produced inconsistent result", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), `"description"`)
+}
+
+func TestCheckConsistentResultMismatchingTypes(t *testing.T) {
+	diags := checkConsistentResult(&severityData{}, &incidentRoleData{})
+	assert.True(t, diags.HasError())
+}
+
+func TestCheckConsistentResultAttributePath(t *testing.T) {
+	planned := customFieldOptionData{
+		Id:            types.StringValue("id"),
+		CustomFieldId: types.StringValue("field"),
+		Value:         types.StringValue("value"),
+		SortKey:       types.Int64Value(1000),
+	}
+	applied := planned
+	applied.SortKey = types.Int64Value(100)
+
+	diags := checkConsistentResult(&planned, &applied)
+	require.Len(t, diags, 1)
+
+	withPath, ok := diags[0].(interface{ Path() path.Path })
+	require.True(t, ok)
+	assert.Equal(t, path.Root("sort_key"), withPath.Path())
+}
+
+func TestCheckConsistentResultIgnoresComputedOnly(t *testing.T) {
+	planned := severityData{
+		Id:        types.StringValue("01FCNDV6P870EA6S7TK1DSYDG0"),
+		Name:      types.StringValue("Major"),
+		CreatedAt: types.StringValue("2023-02-01T10:00:00Z"),
+		UpdatedAt: types.StringValue("2023-02-01T10:00:00Z"),
+	}
+	applied := planned
+	applied.UpdatedAt = types.StringValue("2023-03-01T10:00:00Z")
+
+	diags := checkConsistentResult(&planned, &applied)
+	assert.False(t, diags.HasError(), diags)
+}
+
+func TestKeepPlannedValues(t *testing.T) {
+	planned := customFieldOptionData{
+		Id:            types.StringUnknown(),
+		CustomFieldId: types.StringValue("field"),
+		Value:         types.StringValue("value"),
+		SortKey:       types.Int64Value(1000),
+	}
+	applied := planned
+	applied.Id = types.StringValue("id")
+	applied.SortKey = types.Int64Value(100)
+
+	diags := keepPlannedValues(&planned, &applied, checkConsistentResult(&planned, &applied))
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, 1, diags.WarningsCount())
+
+	// The adopted object isn't replaced: the state keeps the planned value
+	// until the next refresh.
+	assert.Equal(t, types.Int64Value(1000), applied.SortKey)
+	assert.Equal(t, types.StringValue("id"), applied.Id)
+
+	diags = keepPlannedValues(&severityData{}, &incidentRoleData{}, checkConsistentResult(&severityData{}, &incidentRoleData{}))
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CustomFieldDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CustomFieldDataSource{}
+
+// customFieldOptionObjectType is the type of each option returned by the
+// incidentio_custom_field data source.
+var customFieldOptionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":       types.StringType,
+		"value":    types.StringType,
+		"sort_key": types.Int64Type,
+	},
+}
+
+type customFieldOptionObject struct {
+	Id      types.String `tfsdk:"id"`
+	Value   types.String `tfsdk:"value"`
+	SortKey types.Int64  `tfsdk:"sort_key"`
+}
+
+type customFieldDataSourceData struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Required               types.String `tfsdk:"required"`
+	ShowBeforeClosure      types.Bool   `tfsdk:"show_before_closure"`
+	ShowBeforeCreation     types.Bool   `tfsdk:"show_before_creation"`
+	ShowBeforeUpdate       types.Bool   `tfsdk:"show_before_update"`
+	ShowInAnnouncementPost types.Bool   `tfsdk:"show_in_announcement_post"`
+	FieldType              types.String `tfsdk:"field_type"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+	Options                types.List   `tfsdk:"options"`
+	OptionIds              types.Map    `tfsdk:"option_ids"`
+}
+
+// fromMetadata updates the data using the custom field returned by incident.io.
+func (d *customFieldDataSourceData) fromMetadata(field incidentio.CustomFieldMetadata) {
+	d.Id = types.StringValue(field.Id)
+	d.Name = types.StringValue(field.Name)
+	d.Description = types.StringValue(field.Description)
+	d.Required = types.StringValue(string(field.Required))
+	d.ShowBeforeClosure = types.BoolValue(field.ShowBeforeClosure)
+	d.ShowBeforeCreation = types.BoolValue(field.ShowBeforeCreation)
+	d.ShowBeforeUpdate = types.BoolValue(field.ShowBeforeUpdate)
+	d.ShowInAnnouncementPost = types.BoolValue(field.ShowInAnnouncementPost)
+	d.FieldType = types.StringValue(string(field.FieldType))
+	d.CreatedAt = timeValue(field.CreatedAt)
+	d.UpdatedAt = timeValue(field.UpdatedAt)
+}
+
+// setOptions updates the options of the custom field.
+func (d *customFieldDataSourceData) setOptions(ctx context.Context, options []incidentio.CustomFieldOptionMetadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.Options, d.OptionIds, diags = customFieldOptionValues(ctx, options)
+	return diags
+}
+
+// customFieldOptionValues returns the list of the options of a custom field,
+// and the IDs of the options indexed by value.
+func customFieldOptionValues(ctx context.Context, options []incidentio.CustomFieldOptionMetadata) (types.List, types.Map, diag.Diagnostics) {
+	objects := make([]customFieldOptionObject, len(options))
+	optionIds := map[string]string{}
+
+	for i, option := range options {
+		objects[i] = customFieldOptionObject{
+			Id:      types.StringValue(option.Id),
+			Value:   types.StringValue(option.Value),
+			SortKey: types.Int64Value(option.SortKey),
+		}
+		optionIds[option.Value] = option.Id
+	}
+
+	list, diags := types.ListValueFrom(ctx, customFieldOptionObjectType, objects)
+
+	ids, moreDiags := types.MapValueFrom(ctx, types.StringType, optionIds)
+	diags.Append(moreDiags...)
+
+	return list, ids, diags
+}
+
+type CustomFieldDataSource struct {
+	client *providerClient
+}
+
+func NewCustomFieldDataSource() datasource.DataSource {
+	return &CustomFieldDataSource{}
+}
+
+func (d *CustomFieldDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field"
+}
+
+func (d *CustomFieldDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a custom field and its options by ID or name. Exactly one of `id` or `name` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the custom field",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Human readable name of the custom field",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the custom field",
+				Computed:            true,
+			},
+			"field_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the custom field",
+				Computed:            true,
+			},
+			"required": schema.StringAttribute{
+				MarkdownDescription: "When this custom field must be set during the incident lifecycle",
+				Computed:            true,
+			},
+			"show_before_closure": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom field is shown in the incident close modal",
+				Computed:            true,
+			},
+			"show_before_creation": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom field is shown in the incident creation modal",
+				Computed:            true,
+			},
+			"show_before_update": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom field is shown in the incident update modal",
+				Computed:            true,
+			},
+			"show_in_announcement_post": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom field is shown in the announcement post when set",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the custom field was created, in RFC3339 format",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the custom field was last updated, in RFC3339 format",
+				Computed:            true,
+			},
+			"options": schema.ListNestedAttribute{
+				MarkdownDescription: "The options of the custom field, ordered by sort key. This is empty unless the field is a select field.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the custom field option",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the custom field option",
+							Computed:            true,
+						},
+						"sort_key": schema.Int64Attribute{
+							MarkdownDescription: "Sort key used to order the custom field options",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"option_ids": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the options of the custom field, indexed by value",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *CustomFieldDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomFieldDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data customFieldDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkExactlyOneOf(map[string]attr.Value{
+		"id":   data.Id,
+		"name": data.Name,
+	})...)
+}
+
+func (d *CustomFieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data customFieldDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	var field *incidentio.CustomFieldMetadata
+
+	if !data.Id.IsNull() {
+		var err error
+
+		field, err = d.client.CustomFields().Get(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom field, got error: %s", err))
+			return
+		}
+	} else {
+		fields, err := d.client.CustomFields().List(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom fields, got error: %s", err))
+			return
+		}
+
+		found := filter(fields, func(field incidentio.CustomFieldMetadata) bool {
+			return field.Name == data.Name.ValueString()
+		})
+		if len(found) != 1 {
+			resp.Diagnostics.AddError(
+				"Unable to Find Custom Field",
+				fmt.Sprintf("Expected exactly one custom field with name %q, found %d.", data.Name.ValueString(), len(found)),
+			)
+			return
+		}
+
+		field = &found[0]
+	}
+
+	data.fromMetadata(*field)
+
+	var options []incidentio.CustomFieldOptionMetadata
+
+	// Only the select fields have options.
+	if field.FieldType == incidentio.SingleSelect || field.FieldType == incidentio.MultiSelect {
+		var err error
+
+		options, err = d.client.CustomFieldOptions().ListForCustomField(ctx, field.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom field options, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.setOptions(ctx, options)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
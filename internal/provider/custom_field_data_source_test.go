@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestCustomFieldDataSourceSetOptions(t *testing.T) {
+	var data customFieldDataSourceData
+
+	diags := data.setOptions(context.Background(), []incidentio.CustomFieldOptionMetadata{
+		{Id: "1", CustomFieldOption: incidentio.CustomFieldOption{Value: "Core", SortKey: 10}},
+		{Id: "2", CustomFieldOption: incidentio.CustomFieldOption{Value: "Payments", SortKey: 20}},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	assert.Len(t, data.Options.Elements(), 2)
+	assert.Equal(t, `"2"`, data.OptionIds.Elements()["Payments"].String())
+
+	diags = data.setOptions(context.Background(), nil)
+	require.False(t, diags.HasError(), diags)
+	assert.Empty(t, data.Options.Elements())
+	assert.False(t, data.OptionIds.IsNull())
+}
+
+func TestAccCustomFieldDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldOptionResourceConfig("single_select", "option lookup", 10) + `
+	data "incidentio_custom_field" "by_name" {
+		name = incidentio_custom_field.test.name
+
+		depends_on = [incidentio_custom_field_option.test]
+	}
+
+	data "incidentio_custom_field" "by_id" {
+		id = incidentio_custom_field.test.id
+
+		depends_on = [incidentio_custom_field_option.test]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.incidentio_custom_field.by_name", "id", "incidentio_custom_field.test", "id"),
+					resource.TestCheckResourceAttr("data.incidentio_custom_field.by_id", "field_type", "single_select"),
+					resource.TestCheckResourceAttr("data.incidentio_custom_field.by_id", "options.0.value", "option lookup"),
+					resource.TestCheckResourceAttrPair("data.incidentio_custom_field.by_id", "option_ids.option lookup", "incidentio_custom_field_option.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,19 +18,30 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &CustomFieldOptionResource{}
 var _ resource.ResourceWithImportState = &CustomFieldOptionResource{}
+var _ resource.ResourceWithModifyPlan = &CustomFieldOptionResource{}
 
 type customFieldOptionData struct {
-	Id            types.String `tfsdk:"id"`
-	CustomFieldId types.String `tfsdk:"custom_field_id"`
-	Value         types.String `tfsdk:"value"`
-	SortKey       types.Int64  `tfsdk:"sort_key"`
+	Id            types.String   `tfsdk:"id"`
+	CustomFieldId types.String   `tfsdk:"custom_field_id"`
+	Value         types.String   `tfsdk:"value"`
+	SortKey       types.Int64    `tfsdk:"sort_key"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// fromMetadata updates the data using the custom field option returned by incident.io.
+func (d *customFieldOptionData) fromMetadata(option incidentio.CustomFieldOptionMetadata) {
+	d.Id = types.StringValue(option.Id)
+	d.CustomFieldId = types.StringValue(option.CustomFieldId)
+	d.Value = types.StringValue(option.Value)
+	d.SortKey = types.Int64Value(option.SortKey)
 }
 
 type CustomFieldOptionResource struct {
 	// client is the SDK used to communicate with the incident.io service.
 	// Resource and DataSource implementations can then make calls using this
 	// client.
-	client *incidentio.Client
+	client *providerClient
 }
 
 func NewCustomFieldOptionResource() resource.Resource {
@@ -68,6 +80,20 @@ func (r *CustomFieldOptionResource) Schema(ctx context.Context, req resource.Sch
 					int64DefaultValue(10),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt the existing option with the same value in the custom field instead of creating a new one. " +
+					"Defaults to the `adopt_existing` setting of the provider.",
+				Optional: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -78,12 +104,12 @@ func (r *CustomFieldOptionResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*incidentio.Client)
+	client, ok := req.ProviderData.(*providerClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *incidentio.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -93,6 +119,10 @@ func (r *CustomFieldOptionResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *CustomFieldOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "create", "custom field option") {
+		return
+	}
+
 	var data customFieldOptionData
 
 	diags := req.Plan.Get(ctx, &data)
@@ -102,22 +132,33 @@ func (r *CustomFieldOptionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	timeout, diags := data.Timeouts.Create(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	newCustomFieldOption := incidentio.CustomFieldOption{
 		CustomFieldId: data.CustomFieldId.ValueString(),
 		Value:         data.Value.ValueString(),
 		SortKey:       data.SortKey.ValueInt64(),
 	}
-	response, err := r.client.CustomFieldOptions().Create(newCustomFieldOption)
+	response, adopted, err := createOrAdopt(ctx, &resp.Diagnostics, r.client.CustomFieldOptions().Service, r.client.shouldAdoptExisting(data.AdoptExisting), newCustomFieldOption,
+		func(option incidentio.CustomFieldOptionMetadata) string { return option.Id })
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field option, got error: %s", err))
+		addCreateError(ctx, resp, "custom field option", err)
 		return
 	}
 
-	data.Id = types.StringValue(response.CustomFieldOption.Id)
-	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.CustomFieldOption.Id))
+	planned := data
+	data.fromMetadata(*response)
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.Id))
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, adopted)
 }
 
 func (r *CustomFieldOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -130,9 +171,19 @@ func (r *CustomFieldOptionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	id := data.Id.ValueString()
 
-	response, err := r.client.CustomFieldOptions().Get(id)
+	response, err := r.client.CustomFieldOptions().Get(ctx, id)
 	if incidentio.IsErrorStatus(err, 404) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -143,16 +194,17 @@ func (r *CustomFieldOptionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	data.Id = types.StringValue(response.CustomFieldOption.Id)
-	data.CustomFieldId = types.StringValue(response.CustomFieldOption.CustomFieldId)
-	data.Value = types.StringValue(response.CustomFieldOption.Value)
-	data.SortKey = types.Int64Value(response.CustomFieldOption.SortKey)
+	data.fromMetadata(*response)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CustomFieldOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "update", "custom field option") {
+		return
+	}
+
 	var data customFieldOptionData
 
 	diags := req.Plan.Get(ctx, &data)
@@ -162,6 +214,16 @@ func (r *CustomFieldOptionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	timeout, diags := data.Timeouts.Update(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	id := data.Id.ValueString()
 	updatedCFO := incidentio.CustomFieldOption{
 		CustomFieldId: data.CustomFieldId.ValueString(),
@@ -169,17 +231,23 @@ func (r *CustomFieldOptionResource) Update(ctx context.Context, req resource.Upd
 		SortKey:       data.SortKey.ValueInt64(),
 	}
 
-	_, err := r.client.CustomFieldOptions().Update(id, updatedCFO)
+	response, err := r.client.CustomFieldOptions().Update(ctx, id, updatedCFO)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field option, got error: %s", err))
 		return
 	}
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	planned := data
+	data.fromMetadata(*response)
+
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, false)
 }
 
 func (r *CustomFieldOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "delete", "custom field option") {
+		return
+	}
+
 	var data customFieldOptionData
 
 	diags := req.State.Get(ctx, &data)
@@ -189,7 +257,17 @@ func (r *CustomFieldOptionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.CustomFieldOptions().Delete(data.Id.ValueString())
+	timeout, diags := data.Timeouts.Delete(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.client.CustomFieldOptions().Delete(ctx, data.Id.ValueString())
 	if incidentio.IsErrorStatus(err, 404) {
 		// The resource is already gone.
 		return
@@ -201,6 +279,50 @@ func (r *CustomFieldOptionResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *CustomFieldOptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only check the custom field options being destroyed.
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data customFieldOptionData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id := data.Id.ValueString()
+	customFieldId := data.CustomFieldId.ValueString()
+	subject := fmt.Sprintf("custom field option %q", data.Value.ValueString())
+
+	warnIncidentUsage(ctx, r.client, &resp.Diagnostics, subject, func(entry incidentio.CustomFieldEntry) bool {
+		if entry.CustomField.Id != customFieldId {
+			return false
+		}
+
+		for _, value := range entry.Values {
+			if value.ValueOption != nil && value.ValueOption.Id == id {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
 func (r *CustomFieldOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
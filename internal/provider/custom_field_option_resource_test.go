@@ -19,6 +19,8 @@ func testAccCustomFieldOptionResourceConfig(field_type string, value string, sor
 		show_before_closure  = true
 		show_before_creation = true
 		show_before_update   = false
+
+		deletion_protection = false
 	}
 
 	resource "incidentio_custom_field_option" "test" {
@@ -26,6 +28,11 @@ func testAccCustomFieldOptionResourceConfig(field_type string, value string, sor
 
 		value    = "%s"
 		sort_key = %d
+
+		timeouts {
+			create = "1m"
+			read   = "30s"
+		}
 	}
 
 `, field_type, value, sort)
@@ -53,7 +60,11 @@ func TestAccCustomFieldOptionResource(t *testing.T) {
 				// example code does not have an actual upstream service.
 				// Once the Read method is able to refresh information from
 				// the upstream service, this can be removed.
-				ImportStateVerifyIgnore: []string{"name"},
+				ImportStateVerifyIgnore: []string{
+					"name",
+					// The timeouts block is never set on imported resources.
+					"timeouts",
+				},
 			},
 			// Update and Read testing
 			{
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CustomFieldOptionsDataSource{}
+
+type customFieldOptionsDataSourceData struct {
+	Id            types.String `tfsdk:"id"`
+	CustomFieldId types.String `tfsdk:"custom_field_id"`
+	Options       types.List   `tfsdk:"options"`
+	ByValue       types.Map    `tfsdk:"by_value"`
+	ById          types.Map    `tfsdk:"by_id"`
+}
+
+// setOptions updates the options of the custom field and their indexes.
+func (d *customFieldOptionsDataSourceData) setOptions(ctx context.Context, options []incidentio.CustomFieldOptionMetadata) diag.Diagnostics {
+	var diags, moreDiags diag.Diagnostics
+
+	d.Options, d.ByValue, diags = customFieldOptionValues(ctx, options)
+
+	byId := map[string]string{}
+	for _, option := range options {
+		byId[option.Id] = option.Value
+	}
+
+	d.ById, moreDiags = types.MapValueFrom(ctx, types.StringType, byId)
+	diags.Append(moreDiags...)
+
+	return diags
+}
+
+type CustomFieldOptionsDataSource struct {
+	client *providerClient
+}
+
+func NewCustomFieldOptionsDataSource() datasource.DataSource {
+	return &CustomFieldOptionsDataSource{}
+}
+
+func (d *CustomFieldOptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field_options"
+}
+
+func (d *CustomFieldOptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all the options of a select custom field, ordered by sort key",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data source, the ID of the custom field",
+				Computed:            true,
+			},
+			"custom_field_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the custom field",
+				Required:            true,
+			},
+			"options": schema.ListNestedAttribute{
+				MarkdownDescription: "The options of the custom field, ordered by sort key",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the custom field option",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the custom field option",
+							Computed:            true,
+						},
+						"sort_key": schema.Int64Attribute{
+							MarkdownDescription: "Sort key used to order the custom field options",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"by_value": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the options, indexed by value",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"by_id": schema.MapAttribute{
+				MarkdownDescription: "The values of the options, indexed by ID",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *CustomFieldOptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomFieldOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data customFieldOptionsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	options, err := d.client.CustomFieldOptions().ListForCustomField(ctx, data.CustomFieldId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom field options, got error: %s", err))
+		return
+	}
+
+	data.Id = data.CustomFieldId
+
+	resp.Diagnostics.Append(data.setOptions(ctx, options)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestCustomFieldOptionsDataSourceSetOptions(t *testing.T) {
+	var data customFieldOptionsDataSourceData
+	diags := data.setOptions(context.Background(), []incidentio.CustomFieldOptionMetadata{
+		{Id: "1", CustomFieldOption: incidentio.CustomFieldOption{Value: "Core", SortKey: 10}},
+		{Id: "2", CustomFieldOption: incidentio.CustomFieldOption{Value: "Payments", SortKey: 20}},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	assert.Len(t, data.Options.Elements(), 2)
+	assert.Equal(t, map[string]attr.Value{
+		"Core":     types.StringValue("1"),
+		"Payments": types.StringValue("2"),
+	}, data.ByValue.Elements())
+	assert.Equal(t, map[string]attr.Value{
+		"1": types.StringValue("Core"),
+		"2": types.StringValue("Payments"),
+	}, data.ById.Elements())
+}
+
+func TestAccCustomFieldOptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldOptionResourceConfig("single_select", "option list", 10) + `
+	data "incidentio_custom_field_options" "test" {
+		custom_field_id = incidentio_custom_field.test.id
+
+		depends_on = [incidentio_custom_field_option.test]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.incidentio_custom_field_options.test", "id", "incidentio_custom_field.test", "id"),
+					resource.TestCheckResourceAttr("data.incidentio_custom_field_options.test", "options.0.value", "option list"),
+					resource.TestCheckResourceAttrPair("data.incidentio_custom_field_options.test", "by_value.option list", "incidentio_custom_field_option.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,23 +19,45 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &CustomFieldResource{}
 var _ resource.ResourceWithImportState = &CustomFieldResource{}
+var _ resource.ResourceWithModifyPlan = &CustomFieldResource{}
 
 type customField struct {
-	Id                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Required           types.String `tfsdk:"required"`
-	ShowBeforeClosure  types.Bool   `tfsdk:"show_before_closure"`
-	ShowBeforeCreation types.Bool   `tfsdk:"show_before_creation"`
-	ShowBeforeUpdate   types.Bool   `tfsdk:"show_before_update"`
-	FieldType          types.String `tfsdk:"field_type"`
+	Id                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	Required               types.String   `tfsdk:"required"`
+	ShowBeforeClosure      types.Bool     `tfsdk:"show_before_closure"`
+	ShowBeforeCreation     types.Bool     `tfsdk:"show_before_creation"`
+	ShowBeforeUpdate       types.Bool     `tfsdk:"show_before_update"`
+	ShowInAnnouncementPost types.Bool     `tfsdk:"show_in_announcement_post"`
+	FieldType              types.String   `tfsdk:"field_type"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	UpdatedAt              types.String   `tfsdk:"updated_at"`
+	AdoptExisting          types.Bool     `tfsdk:"adopt_existing"`
+	DeletionProtection     types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// fromMetadata updates the data using the custom field returned by incident.io.
+func (d *customField) fromMetadata(field incidentio.CustomFieldMetadata) {
+	d.Id = types.StringValue(field.Id)
+	d.Name = types.StringValue(field.Name)
+	d.Description = types.StringValue(field.Description)
+	d.Required = types.StringValue(string(field.Required))
+	d.ShowBeforeClosure = types.BoolValue(field.ShowBeforeClosure)
+	d.ShowBeforeCreation = types.BoolValue(field.ShowBeforeCreation)
+	d.ShowBeforeUpdate = types.BoolValue(field.ShowBeforeUpdate)
+	d.ShowInAnnouncementPost = types.BoolValue(field.ShowInAnnouncementPost)
+	d.FieldType = types.StringValue(string(field.FieldType))
+	d.CreatedAt = timeValue(field.CreatedAt)
+	d.UpdatedAt = timeValue(field.UpdatedAt)
 }
 
 type CustomFieldResource struct {
 	// client is the SDK used to communicate with the incident.io service.
 	// Resource and DataSource implementations can then make calls using this
 	// client.
-	client *incidentio.Client
+	client *providerClient
 }
 
 func NewCustomFieldResource() resource.Resource {
@@ -57,6 +80,17 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the custom field was created, in RFC3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the custom field was last updated, in RFC3339 format",
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Human readable name of the custom field",
 				Required:            true,
@@ -111,6 +145,37 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 					boolDefaultValue(true),
 				},
 			},
+			"show_in_announcement_post": schema.BoolAttribute{
+				MarkdownDescription: "Whether a custom field should be shown in the list of fields as part of the announcement post when set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefaultValue(false),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt the existing custom field with the same name instead of creating a new one. " +
+					"Defaults to the `adopt_existing` setting of the provider.",
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from deleting the custom field, which would also remove it from all the past incidents. " +
+					"It must be set to `false` and applied before the custom field can be destroyed. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefaultValue(true),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -121,12 +186,12 @@ func (r *CustomFieldResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*incidentio.Client)
+	client, ok := req.ProviderData.(*providerClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *incidentio.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -136,6 +201,10 @@ func (r *CustomFieldResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "create", "custom field") {
+		return
+	}
+
 	var data customField
 
 	diags := req.Plan.Get(ctx, &data)
@@ -145,27 +214,39 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	timeout, diags := data.Timeouts.Create(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	newCF := incidentio.CustomField{
-		Name:               data.Name.ValueString(),
-		Description:        data.Description.ValueString(),
-		Required:           incidentio.FieldRequirement(data.Required.ValueString()),
-		ShowBeforeClosure:  data.ShowBeforeClosure.ValueBool(),
-		ShowBeforeCreation: data.ShowBeforeCreation.ValueBool(),
-		ShowBeforeUpdate:   data.ShowBeforeUpdate.ValueBool(),
-		FieldType:          incidentio.FieldType(data.FieldType.ValueString()),
+		Name:                   data.Name.ValueString(),
+		Description:            data.Description.ValueString(),
+		Required:               incidentio.FieldRequirement(data.Required.ValueString()),
+		ShowBeforeClosure:      data.ShowBeforeClosure.ValueBool(),
+		ShowBeforeCreation:     data.ShowBeforeCreation.ValueBool(),
+		ShowBeforeUpdate:       data.ShowBeforeUpdate.ValueBool(),
+		ShowInAnnouncementPost: data.ShowInAnnouncementPost.ValueBool(),
+		FieldType:              incidentio.FieldType(data.FieldType.ValueString()),
 	}
 
-	response, err := r.client.CustomFields().Create(newCF)
+	response, adopted, err := createOrAdopt(ctx, &resp.Diagnostics, r.client.CustomFields().Service, r.client.shouldAdoptExisting(data.AdoptExisting), newCF,
+		func(field incidentio.CustomFieldMetadata) string { return field.Id })
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field, got error: %s", err))
+		addCreateError(ctx, resp, "custom field", err)
 		return
 	}
 
-	data.Id = types.StringValue(response.CustomField.Id)
-	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.CustomField.Id))
+	planned := data
+	data.fromMetadata(*response)
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.Id))
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, adopted)
 }
 
 func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -178,33 +259,46 @@ func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	id := data.Id.ValueString()
 
-	response, err := r.client.CustomFields().Get(id)
+	response, err := r.client.CustomFields().Get(ctx, id)
 	if incidentio.IsErrorStatus(err, 404) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom field option, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom field, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(response.CustomField.Id)
-	data.Name = types.StringValue(response.CustomField.Name)
-	data.Description = types.StringValue(response.CustomField.Description)
-	data.Required = types.StringValue(string(response.CustomField.Required))
-	data.ShowBeforeClosure = types.BoolValue(response.CustomField.ShowBeforeClosure)
-	data.ShowBeforeCreation = types.BoolValue(response.CustomField.ShowBeforeCreation)
-	data.ShowBeforeUpdate = types.BoolValue(response.CustomField.ShowBeforeUpdate)
-	data.FieldType = types.StringValue(string(response.CustomField.FieldType))
+	data.fromMetadata(*response)
+
+	// Resources imported or created before deletion protection existed are
+	// protected by default.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(true)
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "update", "custom field") {
+		return
+	}
+
 	var data customField
 
 	diags := req.Plan.Get(ctx, &data)
@@ -214,29 +308,46 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	timeout, diags := data.Timeouts.Update(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	cfId := data.Id.ValueString()
 
 	updatedCF := incidentio.CustomField{
-		Name:               data.Name.ValueString(),
-		Description:        data.Description.ValueString(),
-		Required:           incidentio.FieldRequirement(data.Required.ValueString()),
-		ShowBeforeClosure:  data.ShowBeforeClosure.ValueBool(),
-		ShowBeforeCreation: data.ShowBeforeCreation.ValueBool(),
-		ShowBeforeUpdate:   data.ShowBeforeUpdate.ValueBool(),
-		FieldType:          incidentio.FieldType(data.FieldType.ValueString()),
+		Name:                   data.Name.ValueString(),
+		Description:            data.Description.ValueString(),
+		Required:               incidentio.FieldRequirement(data.Required.ValueString()),
+		ShowBeforeClosure:      data.ShowBeforeClosure.ValueBool(),
+		ShowBeforeCreation:     data.ShowBeforeCreation.ValueBool(),
+		ShowBeforeUpdate:       data.ShowBeforeUpdate.ValueBool(),
+		ShowInAnnouncementPost: data.ShowInAnnouncementPost.ValueBool(),
+		FieldType:              incidentio.FieldType(data.FieldType.ValueString()),
 	}
 
-	_, err := r.client.CustomFields().Update(cfId, updatedCF)
+	response, err := r.client.CustomFields().Update(ctx, cfId, updatedCF)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s", err))
 		return
 	}
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	planned := data
+	data.fromMetadata(*response)
+
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, false)
 }
 
 func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "delete", "custom field") {
+		return
+	}
+
 	var data customField
 
 	diags := req.State.Get(ctx, &data)
@@ -246,7 +357,28 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.CustomFields().Delete(data.Id.ValueString())
+	timeout, diags := data.Timeouts.Delete(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("The custom field %q (%s) can't be deleted because its deletion protection is enabled. "+
+				"Deleting it would also remove it from all the past incidents. "+
+				"Set `deletion_protection = false` and apply this change first if you really want to delete it.",
+				data.Name.ValueString(), data.Id.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.CustomFields().Delete(ctx, data.Id.ValueString())
 	if incidentio.IsErrorStatus(err, 404) {
 		// The resource is already gone.
 		return
@@ -258,6 +390,39 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *CustomFieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only check the custom fields being destroyed.
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data customField
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id := data.Id.ValueString()
+	subject := fmt.Sprintf("custom field %q", data.Name.ValueString())
+
+	warnIncidentUsage(ctx, r.client, &resp.Diagnostics, subject, func(entry incidentio.CustomFieldEntry) bool {
+		return entry.CustomField.Id == id && len(entry.Values) > 0
+	})
+}
+
 func (r *CustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
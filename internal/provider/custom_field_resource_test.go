@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -11,6 +12,10 @@ import (
 )
 
 func testAccCustomFieldResourceConfig(name string, required string, field_type string) string {
+	return testAccCustomFieldResourceConfigWithAnnouncement(name, required, field_type, false)
+}
+
+func testAccCustomFieldResourceConfigWithAnnouncement(name string, required string, field_type string, announce bool) string {
 	return fmt.Sprintf(`
 	resource "incidentio_custom_field" "test" {
 		name         = "%s"
@@ -18,13 +23,16 @@ func testAccCustomFieldResourceConfig(name string, required string, field_type s
 
 		required = "%s"
 
-		show_before_closure  = true
-		show_before_creation = true
-		show_before_update   = true
+		show_before_closure       = true
+		show_before_creation      = true
+		show_before_update        = true
+		show_in_announcement_post = %v
+
+		deletion_protection = false
 
 		field_type = "%s"
 	}
-`, name, required, field_type)
+`, name, required, announce, field_type)
 }
 
 func TestAccCustomFieldResource(t *testing.T) {
@@ -39,6 +47,9 @@ func TestAccCustomFieldResource(t *testing.T) {
 					resource.TestCheckResourceAttr("incidentio_custom_field.test", "name", "field1"),
 					resource.TestCheckResourceAttr("incidentio_custom_field.test", "required", "always"),
 					resource.TestCheckResourceAttr("incidentio_custom_field.test", "field_type", "text"),
+					resource.TestCheckResourceAttr("incidentio_custom_field.test", "show_in_announcement_post", "false"),
+					resource.TestCheckResourceAttrSet("incidentio_custom_field.test", "created_at"),
+					resource.TestCheckResourceAttrSet("incidentio_custom_field.test", "updated_at"),
 				),
 			},
 			// ImportState testing
@@ -50,15 +61,16 @@ func TestAccCustomFieldResource(t *testing.T) {
 				// example code does not have an actual upstream service.
 				// Once the Read method is able to refresh information from
 				// the upstream service, this can be removed.
-				ImportStateVerifyIgnore: []string{"name"},
+				ImportStateVerifyIgnore: []string{"name", "deletion_protection"},
 			},
 			// Update and Read testing
 			{
-				Config: testAccCustomFieldResourceConfig("field2", "never", "text"),
+				Config: testAccCustomFieldResourceConfigWithAnnouncement("field2", "never", "text", true),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("incidentio_custom_field.test", "name", "field2"),
 					resource.TestCheckResourceAttr("incidentio_custom_field.test", "required", "never"),
 					resource.TestCheckResourceAttr("incidentio_custom_field.test", "field_type", "text"),
+					resource.TestCheckResourceAttr("incidentio_custom_field.test", "show_in_announcement_post", "true"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -107,7 +119,7 @@ func deleteCustomField(resourceName string) func(*terraform.State) error {
 			return fmt.Errorf("ID not found for resource %s", resourceName)
 		}
 
-		err := client.CustomFields().Delete(id)
+		err := client.CustomFields().Delete(context.Background(), id)
 		if err != nil {
 			return err
 		}
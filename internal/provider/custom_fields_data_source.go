@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CustomFieldsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CustomFieldsDataSource{}
+
+// customFieldObjectType is the type of each custom field returned by the
+// incidentio_custom_fields data source.
+var customFieldObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                        types.StringType,
+		"name":                      types.StringType,
+		"description":               types.StringType,
+		"required":                  types.StringType,
+		"show_before_closure":       types.BoolType,
+		"show_before_creation":      types.BoolType,
+		"show_before_update":        types.BoolType,
+		"show_in_announcement_post": types.BoolType,
+		"field_type":                types.StringType,
+		"created_at":                types.StringType,
+		"updated_at":                types.StringType,
+	},
+}
+
+type customFieldObject struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Required               types.String `tfsdk:"required"`
+	ShowBeforeClosure      types.Bool   `tfsdk:"show_before_closure"`
+	ShowBeforeCreation     types.Bool   `tfsdk:"show_before_creation"`
+	ShowBeforeUpdate       types.Bool   `tfsdk:"show_before_update"`
+	ShowInAnnouncementPost types.Bool   `tfsdk:"show_in_announcement_post"`
+	FieldType              types.String `tfsdk:"field_type"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+}
+
+// fromMetadata updates the object using the custom field returned by incident.io.
+func (d *customFieldObject) fromMetadata(field incidentio.CustomFieldMetadata) {
+	d.Id = types.StringValue(field.Id)
+	d.Name = types.StringValue(field.Name)
+	d.Description = types.StringValue(field.Description)
+	d.Required = types.StringValue(string(field.Required))
+	d.ShowBeforeClosure = types.BoolValue(field.ShowBeforeClosure)
+	d.ShowBeforeCreation = types.BoolValue(field.ShowBeforeCreation)
+	d.ShowBeforeUpdate = types.BoolValue(field.ShowBeforeUpdate)
+	d.ShowInAnnouncementPost = types.BoolValue(field.ShowInAnnouncementPost)
+	d.FieldType = types.StringValue(string(field.FieldType))
+	d.CreatedAt = timeValue(field.CreatedAt)
+	d.UpdatedAt = timeValue(field.UpdatedAt)
+}
+
+type customFieldsDataSourceData struct {
+	Id           types.String `tfsdk:"id"`
+	FieldType    types.String `tfsdk:"field_type"`
+	Required     types.String `tfsdk:"required"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	CustomFields types.List   `tfsdk:"custom_fields"`
+}
+
+// matcher returns a function matching the custom fields selected by the
+// filters of the data source.
+func (d *customFieldsDataSourceData) matcher() (func(field incidentio.CustomFieldMetadata) bool, error) {
+	var nameRegex *regexp.Regexp
+
+	if !d.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(d.NameRegex.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func(field incidentio.CustomFieldMetadata) bool {
+		if !d.FieldType.IsNull() && string(field.FieldType) != d.FieldType.ValueString() {
+			return false
+		}
+
+		if !d.Required.IsNull() && string(field.Required) != d.Required.ValueString() {
+			return false
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(field.Name) {
+			return false
+		}
+
+		return true
+	}, nil
+}
+
+type CustomFieldsDataSource struct {
+	client *providerClient
+}
+
+func NewCustomFieldsDataSource() datasource.DataSource {
+	return &CustomFieldsDataSource{}
+}
+
+func (d *CustomFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_fields"
+}
+
+func (d *CustomFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the custom fields, optionally filtered by type, requirement or name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data source",
+				Computed:            true,
+			},
+			"field_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the custom fields of this type. Must be one of `single_select`, `multi_select`, `text`, `link` or `numeric`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isValidCustomFieldFieldType(),
+				},
+			},
+			"required": schema.StringAttribute{
+				MarkdownDescription: "Only return the custom fields with this requirement. Must be one of `never`, `before_closure` or `always`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isValidCustomFieldRequired(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the custom fields whose name matches this regular expression",
+				Optional:            true,
+			},
+			"custom_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching custom fields",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the custom field",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the custom field",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the custom field",
+							Computed:            true,
+						},
+						"field_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the custom field",
+							Computed:            true,
+						},
+						"required": schema.StringAttribute{
+							MarkdownDescription: "When this custom field must be set during the incident lifecycle",
+							Computed:            true,
+						},
+						"show_before_closure": schema.BoolAttribute{
+							MarkdownDescription: "Whether the custom field is shown in the incident close modal",
+							Computed:            true,
+						},
+						"show_before_creation": schema.BoolAttribute{
+							MarkdownDescription: "Whether the custom field is shown in the incident creation modal",
+							Computed:            true,
+						},
+						"show_before_update": schema.BoolAttribute{
+							MarkdownDescription: "Whether the custom field is shown in the incident update modal",
+							Computed:            true,
+						},
+						"show_in_announcement_post": schema.BoolAttribute{
+							MarkdownDescription: "Whether the custom field is shown in the announcement post when set",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the custom field was created, in RFC3339 format",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the custom field was last updated, in RFC3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomFieldsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data customFieldsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := data.matcher(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("Unable to parse the name regular expression, got error: %s", err),
+		)
+	}
+}
+
+func (d *CustomFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data customFieldsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	match, err := data.matcher()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("Unable to parse the name regular expression, got error: %s", err),
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	response, err := d.client.CustomFields().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom fields, got error: %s", err))
+		return
+	}
+
+	found := filter(response, match)
+
+	fields := make([]customFieldObject, len(found))
+	for i, field := range found {
+		fields[i].fromMetadata(field)
+	}
+
+	data.Id = types.StringValue("custom_fields")
+
+	data.CustomFields, diags = types.ListValueFrom(ctx, customFieldObjectType, fields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
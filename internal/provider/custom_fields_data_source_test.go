@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestCustomFieldsDataSourceMatcher(t *testing.T) {
+	team := incidentio.CustomFieldMetadata{
+		CustomField: incidentio.CustomField{Name: "Affected Team", FieldType: incidentio.MultiSelect, Required: incidentio.Always},
+	}
+	notes := incidentio.CustomFieldMetadata{
+		CustomField: incidentio.CustomField{Name: "Notes", FieldType: incidentio.Text, Required: incidentio.Never},
+	}
+
+	data := customFieldsDataSourceData{
+		FieldType: types.StringNull(),
+		Required:  types.StringNull(),
+		NameRegex: types.StringNull(),
+	}
+
+	match, err := data.matcher()
+	require.NoError(t, err)
+	assert.True(t, match(team))
+	assert.True(t, match(notes))
+
+	data.FieldType = types.StringValue("multi_select")
+	match, err = data.matcher()
+	require.NoError(t, err)
+	assert.True(t, match(team))
+	assert.False(t, match(notes))
+
+	data.FieldType = types.StringNull()
+	data.Required = types.StringValue("never")
+	data.NameRegex = types.StringValue("^No")
+	match, err = data.matcher()
+	require.NoError(t, err)
+	assert.False(t, match(team))
+	assert.True(t, match(notes))
+
+	data.NameRegex = types.StringValue("(")
+	_, err = data.matcher()
+	assert.Error(t, err)
+}
+
+func TestCustomFieldObjectType(t *testing.T) {
+	createdAt := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	var field customFieldObject
+	field.fromMetadata(incidentio.CustomFieldMetadata{
+		CustomField: incidentio.CustomField{
+			Name:              "Affected teams",
+			FieldType:         incidentio.MultiSelect,
+			Required:          incidentio.Always,
+			ShowBeforeClosure: true,
+		},
+		Id:        "1",
+		CreatedAt: createdAt,
+	})
+
+	assert.Equal(t, types.StringValue("multi_select"), field.FieldType)
+	assert.Equal(t, types.StringValue("always"), field.Required)
+	assert.Equal(t, types.BoolValue(true), field.ShowBeforeClosure)
+	assert.Equal(t, types.BoolValue(false), field.ShowBeforeCreation)
+	assert.Equal(t, "2023-02-01T10:00:00Z", field.CreatedAt.ValueString())
+	assert.True(t, field.UpdatedAt.IsNull())
+
+	list, diags := types.ListValueFrom(context.Background(), customFieldObjectType, []customFieldObject{field})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, list.Elements(), 1)
+}
+
+func TestAccCustomFieldsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldOptionResourceConfig("single_select", "option", 10) + `
+	data "incidentio_custom_fields" "filtered" {
+		field_type = "single_select"
+		required   = "always"
+		name_regex = "^field1$"
+
+		depends_on = [incidentio_custom_field.test]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.incidentio_custom_fields.filtered", "custom_fields.#", "1"),
+					resource.TestCheckResourceAttrPair("data.incidentio_custom_fields.filtered", "custom_fields.0.id", "incidentio_custom_field.test", "id"),
+				),
+			},
+			{
+				Config: `
+	data "incidentio_custom_fields" "invalid" {
+		field_type = "dropdown"
+	}
+`,
+				ExpectError: regexp.MustCompile("Invalid field type"),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// addCreateError reports an error which happened while creating an object.
+//
+// If incident.io created the object anyway, its ID is recorded in the state so
+// the object is not lost: Terraform will then consider it as tainted and
+// replace it on the next apply.
+func addCreateError(ctx context.Context, resp *resource.CreateResponse, objectName string, err error) {
+	var partial *incidentio.PartiallyCreatedError
+	if errors.As(err, &partial) {
+		diags := resp.State.SetAttribute(ctx, path.Root("id"), partial.ID)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, got error: %s", objectName, err))
+}
+
+// checkWritable reports an error if the provider is read-only, in which case
+// the object must not be modified.
+func checkWritable(client *providerClient, diags *diag.Diagnostics, operation string, objectName string) bool {
+	if !client.readOnly {
+		return true
+	}
+
+	diags.AddError(
+		"Read-Only Provider",
+		fmt.Sprintf("Unable to %s %s: the provider is configured to be read-only, no changes can be made to incident.io. "+
+			"Unset the `read_only` provider attribute or the `INCIDENT_IO_READ_ONLY` environment variable to apply this change.",
+			operation, objectName),
+	)
+
+	return false
+}
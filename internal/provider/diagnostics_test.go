@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckWritable(t *testing.T) {
+	var diags diag.Diagnostics
+
+	assert.True(t, checkWritable(&providerClient{}, &diags, "create", "severity"))
+	assert.Empty(t, diags)
+
+	assert.False(t, checkWritable(&providerClient{readOnly: true}, &diags, "create", "severity"))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Read-Only Provider", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "Unable to create severity")
+}
@@ -0,0 +1,417 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &IncidentDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IncidentDataSource{}
+
+// incidentRoleAssignmentObjectType is the type of each role assignment
+// returned by the incidentio_incident data source.
+var incidentRoleAssignmentObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"role_id":        types.StringType,
+		"role_name":      types.StringType,
+		"role_type":      types.StringType,
+		"assignee_id":    types.StringType,
+		"assignee_name":  types.StringType,
+		"assignee_email": types.StringType,
+	},
+}
+
+type incidentRoleAssignmentObject struct {
+	RoleId        types.String `tfsdk:"role_id"`
+	RoleName      types.String `tfsdk:"role_name"`
+	RoleType      types.String `tfsdk:"role_type"`
+	AssigneeId    types.String `tfsdk:"assignee_id"`
+	AssigneeName  types.String `tfsdk:"assignee_name"`
+	AssigneeEmail types.String `tfsdk:"assignee_email"`
+}
+
+// incidentCustomFieldEntryObjectType is the type of each custom field entry
+// returned by the incidentio_incident data source.
+var incidentCustomFieldEntryObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"custom_field_id": types.StringType,
+		"name":            types.StringType,
+		"field_type":      types.StringType,
+		"values":          types.ListType{ElemType: types.StringType},
+		"option_ids":      types.ListType{ElemType: types.StringType},
+	},
+}
+
+type incidentCustomFieldEntryObject struct {
+	CustomFieldId types.String `tfsdk:"custom_field_id"`
+	Name          types.String `tfsdk:"name"`
+	FieldType     types.String `tfsdk:"field_type"`
+	Values        types.List   `tfsdk:"values"`
+	OptionIds     types.List   `tfsdk:"option_ids"`
+}
+
+// customFieldValueString returns the value of a custom field entry as a
+// string, whatever the type of the custom field.
+func customFieldValueString(value incidentio.CustomFieldValue) string {
+	switch {
+	case value.ValueOption != nil:
+		return value.ValueOption.Value
+	case value.ValueText != "":
+		return value.ValueText
+	case value.ValueLink != "":
+		return value.ValueLink
+	default:
+		return value.ValueNumeric
+	}
+}
+
+type incidentDataSourceData struct {
+	Id                    types.String `tfsdk:"id"`
+	Reference             types.String `tfsdk:"reference"`
+	Name                  types.String `tfsdk:"name"`
+	Summary               types.String `tfsdk:"summary"`
+	Status                types.String `tfsdk:"status"`
+	Mode                  types.String `tfsdk:"mode"`
+	Visibility            types.String `tfsdk:"visibility"`
+	SeverityId            types.String `tfsdk:"severity_id"`
+	Severity              types.String `tfsdk:"severity"`
+	IncidentTypeId        types.String `tfsdk:"incident_type_id"`
+	IncidentType          types.String `tfsdk:"incident_type"`
+	Permalink             types.String `tfsdk:"permalink"`
+	PostmortemDocumentURL types.String `tfsdk:"postmortem_document_url"`
+	SlackChannelId        types.String `tfsdk:"slack_channel_id"`
+	SlackChannelName      types.String `tfsdk:"slack_channel_name"`
+	CallURL               types.String `tfsdk:"call_url"`
+	RoleAssignments       types.List   `tfsdk:"role_assignments"`
+	CustomFieldEntries    types.List   `tfsdk:"custom_field_entries"`
+	Timestamps            types.Map    `tfsdk:"timestamps"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+// fromIncident updates the data using the incident returned by incident.io.
+func (d *incidentDataSourceData) fromIncident(ctx context.Context, incident incidentio.Incident) diag.Diagnostics {
+	// The attributes shared with the incidentio_incidents data source.
+	var object incidentObject
+	object.fromIncident(incident)
+
+	d.Id = object.Id
+	d.Reference = object.Reference
+	d.Name = object.Name
+	d.Status = object.Status
+	d.Mode = object.Mode
+	d.Visibility = object.Visibility
+	d.SeverityId = object.SeverityId
+	d.Severity = object.Severity
+	d.IncidentTypeId = object.IncidentTypeId
+	d.IncidentType = object.IncidentType
+	d.Permalink = object.Permalink
+	d.SlackChannelName = object.SlackChannelName
+	d.Timestamps = object.Timestamps
+	d.CreatedAt = object.CreatedAt
+	d.UpdatedAt = object.UpdatedAt
+
+	d.Summary = types.StringValue(incident.Summary)
+	d.PostmortemDocumentURL = types.StringValue(incident.PostmortemDocumentURL)
+	d.SlackChannelId = types.StringValue(incident.SlackChannelId)
+	d.CallURL = types.StringValue(incident.CallURL)
+
+	var diags diag.Diagnostics
+
+	assignments := make([]incidentRoleAssignmentObject, len(incident.IncidentRoleAssignments))
+	for i, assignment := range incident.IncidentRoleAssignments {
+		assignments[i] = incidentRoleAssignmentObject{
+			RoleId:        types.StringValue(assignment.Role.Id),
+			RoleName:      types.StringValue(assignment.Role.Name),
+			RoleType:      types.StringValue(string(assignment.Role.RoleType)),
+			AssigneeId:    types.StringNull(),
+			AssigneeName:  types.StringNull(),
+			AssigneeEmail: types.StringNull(),
+		}
+
+		if assignment.Assignee != nil {
+			assignments[i].AssigneeId = types.StringValue(assignment.Assignee.Id)
+			assignments[i].AssigneeName = types.StringValue(assignment.Assignee.Name)
+			assignments[i].AssigneeEmail = types.StringValue(assignment.Assignee.Email)
+		}
+	}
+
+	list, moreDiags := types.ListValueFrom(ctx, incidentRoleAssignmentObjectType, assignments)
+	d.RoleAssignments = list
+	diags.Append(moreDiags...)
+
+	entries := make([]incidentCustomFieldEntryObject, len(incident.CustomFieldEntries))
+	for i, entry := range incident.CustomFieldEntries {
+		values := make([]string, len(entry.Values))
+		optionIds := []string{}
+
+		for j, value := range entry.Values {
+			values[j] = customFieldValueString(value)
+
+			if value.ValueOption != nil {
+				optionIds = append(optionIds, value.ValueOption.Id)
+			}
+		}
+
+		entries[i] = incidentCustomFieldEntryObject{
+			CustomFieldId: types.StringValue(entry.CustomField.Id),
+			Name:          types.StringValue(entry.CustomField.Name),
+			FieldType:     types.StringValue(string(entry.CustomField.FieldType)),
+		}
+
+		entries[i].Values, moreDiags = types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(moreDiags...)
+
+		entries[i].OptionIds, moreDiags = types.ListValueFrom(ctx, types.StringType, optionIds)
+		diags.Append(moreDiags...)
+	}
+
+	list, moreDiags = types.ListValueFrom(ctx, incidentCustomFieldEntryObjectType, entries)
+	d.CustomFieldEntries = list
+	diags.Append(moreDiags...)
+
+	return diags
+}
+
+type IncidentDataSource struct {
+	client *providerClient
+}
+
+func NewIncidentDataSource() datasource.DataSource {
+	return &IncidentDataSource{}
+}
+
+func (d *IncidentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+func (d *IncidentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up an incident by ID or by reference, like `INC-123`. Exactly one of `id` or `reference` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the incident",
+				Optional:            true,
+				Computed:            true,
+			},
+			"reference": schema.StringAttribute{
+				MarkdownDescription: "Human readable reference of the incident, like `INC-123`. " +
+					"Looking up an incident by reference walks through the incidents list until it is found.",
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Explanation of the incident",
+				Computed:            true,
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "Detailed description of the incident",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Current status of the incident",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the incident is real, a test or a tutorial",
+				Computed:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Whether the incident is public or private",
+				Computed:            true,
+			},
+			"severity_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the severity of the incident",
+				Computed:            true,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Name of the severity of the incident",
+				Computed:            true,
+			},
+			"incident_type_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the type of the incident",
+				Computed:            true,
+			},
+			"incident_type": schema.StringAttribute{
+				MarkdownDescription: "Name of the type of the incident",
+				Computed:            true,
+			},
+			"permalink": schema.StringAttribute{
+				MarkdownDescription: "Link to the incident in the incident.io dashboard",
+				Computed:            true,
+			},
+			"postmortem_document_url": schema.StringAttribute{
+				MarkdownDescription: "Link to the postmortem document of the incident",
+				Computed:            true,
+			},
+			"slack_channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Slack channel of the incident",
+				Computed:            true,
+			},
+			"slack_channel_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Slack channel of the incident",
+				Computed:            true,
+			},
+			"call_url": schema.StringAttribute{
+				MarkdownDescription: "Link to the call of the incident",
+				Computed:            true,
+			},
+			"role_assignments": schema.ListNestedAttribute{
+				MarkdownDescription: "The incident roles and who they are assigned to",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the incident role",
+							Computed:            true,
+						},
+						"role_name": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the incident role",
+							Computed:            true,
+						},
+						"role_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the role, one of `lead`, `reporter` or `custom`",
+							Computed:            true,
+						},
+						"assignee_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the user assigned to the role, if any",
+							Computed:            true,
+						},
+						"assignee_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the user assigned to the role, if any",
+							Computed:            true,
+						},
+						"assignee_email": schema.StringAttribute{
+							MarkdownDescription: "Email of the user assigned to the role, if any",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"custom_field_entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The custom fields of the incident and their values",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"custom_field_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the custom field",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the custom field",
+							Computed:            true,
+						},
+						"field_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the custom field",
+							Computed:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "The values of the custom field: the option values for the select fields, or the text, link or number otherwise",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"option_ids": schema.ListAttribute{
+							MarkdownDescription: "The IDs of the selected options, for the select fields",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"timestamps": schema.MapAttribute{
+				MarkdownDescription: "The incident timestamps which occurred, in RFC3339 format and indexed by name, like `Reported at`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the incident was created, in RFC3339 format",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the incident was last updated, in RFC3339 format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *IncidentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IncidentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data incidentDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkExactlyOneOf(map[string]attr.Value{
+		"id":        data.Id,
+		"reference": data.Reference,
+	})...)
+}
+
+func (d *IncidentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data incidentDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	var incident *incidentio.Incident
+	var err error
+
+	if !data.Id.IsNull() {
+		incident, err = d.client.Incidents().Get(ctx, data.Id.ValueString())
+	} else {
+		incident, err = d.client.Incidents().GetByReference(ctx, data.Reference.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get incident, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromIncident(ctx, *incident)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestCustomFieldValueString(t *testing.T) {
+	option := incidentio.CustomFieldOptionMetadata{Id: "1", CustomFieldOption: incidentio.CustomFieldOption{Value: "Product"}}
+
+	assert.Equal(t, "Product", customFieldValueString(incidentio.CustomFieldValue{ValueOption: &option}))
+	assert.Equal(t, "Some text", customFieldValueString(incidentio.CustomFieldValue{ValueText: "Some text"}))
+	assert.Equal(t, "https://example.com", customFieldValueString(incidentio.CustomFieldValue{ValueLink: "https://example.com"}))
+	assert.Equal(t, "42", customFieldValueString(incidentio.CustomFieldValue{ValueNumeric: "42"}))
+}
+
+func TestIncidentDataSourceFromIncident(t *testing.T) {
+	lead := incidentio.IncidentRoleMetadata{
+		IncidentRole: incidentio.IncidentRole{Name: "Incident Lead"},
+		Id:           "01FCNDV6P870EA6S7TK1DSYDG0",
+		RoleType:     incidentio.RoleTypeLead,
+	}
+	reporter := incidentio.IncidentRoleMetadata{
+		IncidentRole: incidentio.IncidentRole{Name: "Reporter"},
+		Id:           "01FCNDV6P870EA6S7TK1DSYDG1",
+		RoleType:     incidentio.RoleTypeReporter,
+	}
+	option := incidentio.CustomFieldOptionMetadata{Id: "01FCNDV6P870EA6S7TK1DSYDG3", CustomFieldOption: incidentio.CustomFieldOption{Value: "Product"}}
+
+	var data incidentDataSourceData
+	diags := data.fromIncident(context.Background(), incidentio.Incident{
+		Id:                    "01FDAG4SAP5TYPT98WGR2N7W91",
+		Reference:             "INC-2",
+		Summary:               "The database ran out of disk space.",
+		PostmortemDocumentURL: "https://docs.example.com/postmortem",
+		IncidentRoleAssignments: []incidentio.IncidentRoleAssignment{
+			{Role: lead, Assignee: &incidentio.User{Id: "01FCQSP07Z74QMMYPDDGQB9FTG", Name: "Lisa Karlin Curtis", Email: "lisa@incident.io"}},
+			{Role: reporter},
+		},
+		CustomFieldEntries: []incidentio.CustomFieldEntry{
+			{
+				CustomField: incidentio.CustomFieldTypeInfo{Id: "01FCNDV6P870EA6S7TK1DSYDG2", Name: "Affected Team", FieldType: incidentio.MultiSelect},
+				Values:      []incidentio.CustomFieldValue{{ValueOption: &option}},
+			},
+			{
+				CustomField: incidentio.CustomFieldTypeInfo{Id: "01FCNDV6P870EA6S7TK1DSYDG4", Name: "Notes", FieldType: incidentio.Text},
+				Values:      []incidentio.CustomFieldValue{{ValueText: "Some text"}},
+			},
+		},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "INC-2", data.Reference.ValueString())
+	assert.Equal(t, "The database ran out of disk space.", data.Summary.ValueString())
+	assert.Equal(t, "https://docs.example.com/postmortem", data.PostmortemDocumentURL.ValueString())
+	assert.True(t, data.Severity.IsNull())
+
+	var assignments []incidentRoleAssignmentObject
+	diags = data.RoleAssignments.ElementsAs(context.Background(), &assignments, false)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, assignments, 2)
+	assert.Equal(t, types.StringValue("lead"), assignments[0].RoleType)
+	assert.Equal(t, types.StringValue("lisa@incident.io"), assignments[0].AssigneeEmail)
+	assert.True(t, assignments[1].AssigneeId.IsNull())
+
+	var entries []incidentCustomFieldEntryObject
+	diags = data.CustomFieldEntries.ElementsAs(context.Background(), &entries, false)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, entries, 2)
+	assert.Equal(t, types.StringValue("Affected Team"), entries[0].Name)
+	assert.Equal(t, []attr.Value{types.StringValue("Product")}, entries[0].Values.Elements())
+	assert.Equal(t, []attr.Value{types.StringValue(option.Id)}, entries[0].OptionIds.Elements())
+	assert.Equal(t, []attr.Value{types.StringValue("Some text")}, entries[1].Values.Elements())
+	assert.Empty(t, entries[1].OptionIds.Elements())
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &IncidentRoleDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IncidentRoleDataSource{}
+
+type incidentRoleDataSourceData struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Required     types.Bool   `tfsdk:"required"`
+	Instructions types.String `tfsdk:"instructions"`
+	ShortForm    types.String `tfsdk:"short_form"`
+	RoleType     types.String `tfsdk:"role_type"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// fromMetadata updates the data using the incident role returned by incident.io.
+func (d *incidentRoleDataSourceData) fromMetadata(role incidentio.IncidentRoleMetadata) {
+	d.Id = types.StringValue(role.Id)
+	d.Name = types.StringValue(role.Name)
+	d.Description = types.StringValue(role.Description)
+	d.Required = types.BoolValue(role.Required)
+	d.Instructions = types.StringValue(role.Instructions)
+	d.ShortForm = types.StringValue(role.ShortForm)
+	d.RoleType = types.StringValue(string(role.RoleType))
+	d.CreatedAt = timeValue(role.CreatedAt)
+	d.UpdatedAt = timeValue(role.UpdatedAt)
+}
+
+type IncidentRoleDataSource struct {
+	client *providerClient
+}
+
+func NewIncidentRoleDataSource() datasource.DataSource {
+	return &IncidentRoleDataSource{}
+}
+
+func (d *IncidentRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_role"
+}
+
+func (d *IncidentRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up an incident role by ID, name, short form or role type. " +
+			"Exactly one of `id`, `name`, `short_form` or `role_type` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the incident role",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Human readable name of the incident role",
+				Optional:            true,
+				Computed:            true,
+			},
+			"short_form": schema.StringAttribute{
+				MarkdownDescription: "Short human readable name for Slack",
+				Optional:            true,
+				Computed:            true,
+			},
+			"role_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the role, one of `lead`, `reporter` or `custom`. " +
+					"Looking up by role type is mostly useful for the built-in `lead` and `reporter` roles.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					isValidIncidentRoleType(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describes the purpose of the role",
+				Computed:            true,
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Whether incident require this role to be set",
+				Computed:            true,
+			},
+			"instructions": schema.StringAttribute{
+				MarkdownDescription: "Provided to whoever is nominated for the role",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the incident role was created, in RFC3339 format",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the incident role was last updated, in RFC3339 format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *IncidentRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IncidentRoleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data incidentRoleDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkExactlyOneOf(map[string]attr.Value{
+		"id":         data.Id,
+		"name":       data.Name,
+		"short_form": data.ShortForm,
+		"role_type":  data.RoleType,
+	})...)
+}
+
+func (d *IncidentRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data incidentRoleDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	if !data.Id.IsNull() {
+		role, err := d.client.IncidentRoles().Get(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get incident role, got error: %s", err))
+			return
+		}
+
+		data.fromMetadata(*role)
+
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	roles, err := d.client.IncidentRoles().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident roles, got error: %s", err))
+		return
+	}
+
+	var criteria string
+	var match func(role incidentio.IncidentRoleMetadata) bool
+
+	switch {
+	case !data.Name.IsNull():
+		criteria = fmt.Sprintf("name %q", data.Name.ValueString())
+		match = func(role incidentio.IncidentRoleMetadata) bool {
+			return role.Name == data.Name.ValueString()
+		}
+	case !data.ShortForm.IsNull():
+		criteria = fmt.Sprintf("short form %q", data.ShortForm.ValueString())
+		match = func(role incidentio.IncidentRoleMetadata) bool {
+			return role.ShortForm == data.ShortForm.ValueString()
+		}
+	default:
+		criteria = fmt.Sprintf("role type %q", data.RoleType.ValueString())
+		match = func(role incidentio.IncidentRoleMetadata) bool {
+			return string(role.RoleType) == data.RoleType.ValueString()
+		}
+	}
+
+	found := filter(roles, match)
+	if len(found) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to Find Incident Role",
+			fmt.Sprintf("Expected exactly one incident role with %s, found %d.", criteria, len(found)),
+		)
+		return
+	}
+
+	data.fromMetadata(found[0])
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
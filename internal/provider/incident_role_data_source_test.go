@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"random": {
+				VersionConstraint: "3.1.3",
+				Source:            "hashicorp/random",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentRoleResourceConfig("role lookup", false) + `
+	data "incidentio_incident_role" "by_name" {
+		name = incidentio_incident_role.test.name
+	}
+
+	data "incidentio_incident_role" "by_short_form" {
+		short_form = incidentio_incident_role.test.short_form
+	}
+
+	data "incidentio_incident_role" "lead" {
+		role_type = "lead"
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.incidentio_incident_role.by_name", "id", "incidentio_incident_role.test", "id"),
+					resource.TestCheckResourceAttrPair("data.incidentio_incident_role.by_short_form", "id", "incidentio_incident_role.test", "id"),
+					resource.TestCheckResourceAttr("data.incidentio_incident_role.by_name", "instructions", "Some instructions"),
+					resource.TestCheckResourceAttr("data.incidentio_incident_role.by_name", "role_type", "custom"),
+					resource.TestCheckResourceAttr("data.incidentio_incident_role.lead", "role_type", "lead"),
+				),
+			},
+			{
+				Config: `
+	data "incidentio_incident_role" "custom" {
+		role_type = "custom"
+		name      = "Communications Lead"
+	}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of"),
+			},
+		},
+	})
+}
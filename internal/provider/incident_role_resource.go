@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/multani/terraform-provider-incidentio/incidentio"
@@ -19,19 +21,37 @@ var _ resource.Resource = &IncidentRoleResource{}
 var _ resource.ResourceWithImportState = &IncidentRoleResource{}
 
 type incidentRoleData struct {
-	Id           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	Required     types.Bool   `tfsdk:"required"`
-	Instructions types.String `tfsdk:"instructions"`
-	ShortForm    types.String `tfsdk:"short_form"`
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Required      types.Bool     `tfsdk:"required"`
+	Instructions  types.String   `tfsdk:"instructions"`
+	ShortForm     types.String   `tfsdk:"short_form"`
+	RoleType      types.String   `tfsdk:"role_type"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	UpdatedAt     types.String   `tfsdk:"updated_at"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// fromMetadata updates the data using the incident role returned by incident.io.
+func (d *incidentRoleData) fromMetadata(role incidentio.IncidentRoleMetadata) {
+	d.Id = types.StringValue(role.Id)
+	d.Name = types.StringValue(role.Name)
+	d.Description = types.StringValue(role.Description)
+	d.Required = types.BoolValue(role.Required)
+	d.Instructions = types.StringValue(role.Instructions)
+	d.ShortForm = types.StringValue(role.ShortForm)
+	d.RoleType = types.StringValue(string(role.RoleType))
+	d.CreatedAt = timeValue(role.CreatedAt)
+	d.UpdatedAt = timeValue(role.UpdatedAt)
 }
 
 type IncidentRoleResource struct {
 	// client is the SDK used to communicate with the incident.io service.
 	// Resource and DataSource implementations can then make calls using this
 	// client.
-	client *incidentio.Client
+	client *providerClient
 }
 
 func NewIncidentRoleResource() resource.Resource {
@@ -44,7 +64,10 @@ func (r *IncidentRoleResource) Metadata(ctx context.Context, req resource.Metada
 
 func (r *IncidentRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Configure an incident role",
+		MarkdownDescription: "Configure an incident role.\n\n" +
+			"The built-in `lead` and `reporter` roles always exist and can't be created nor deleted: " +
+			"set `role_type` to adopt them (or import them using their role type as ID) " +
+			"and destroying them only stops managing them from Terraform.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -54,6 +77,17 @@ func (r *IncidentRoleResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the incident role was created, in RFC3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the incident role was last updated, in RFC3339 format",
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Human readable name of the incident role",
 				Required:            true,
@@ -74,6 +108,33 @@ func (r *IncidentRoleResource) Schema(ctx context.Context, req resource.SchemaRe
 				MarkdownDescription: "Short human readable name for Slack",
 				Required:            true,
 			},
+			"role_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the role. Must be one of `lead`, `reporter` or `custom`. " +
+					"Setting it to `lead` or `reporter` adopts the corresponding built-in role instead of creating a new one.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					isValidIncidentRoleType(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt the existing incident role with the same name instead of creating a new one. " +
+					"Defaults to the `adopt_existing` setting of the provider.",
+				Optional: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -84,12 +145,12 @@ func (r *IncidentRoleResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*incidentio.Client)
+	client, ok := req.ProviderData.(*providerClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *incidentio.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -99,6 +160,10 @@ func (r *IncidentRoleResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "create", "incident role") {
+		return
+	}
+
 	var data incidentRoleData
 
 	diags := req.Plan.Get(ctx, &data)
@@ -108,6 +173,16 @@ func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	timeout, diags := data.Timeouts.Create(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	newRole := incidentio.IncidentRole{
 		Name:         data.Name.ValueString(),
 		Description:  data.Description.ValueString(),
@@ -115,17 +190,31 @@ func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRe
 		Instructions: data.Instructions.ValueString(),
 		ShortForm:    data.ShortForm.ValueString(),
 	}
-	response, err := r.client.IncidentRoles().Create(newRole)
+
+	roleType := incidentio.RoleType(data.RoleType.ValueString())
+
+	var response *incidentio.IncidentRoleMetadata
+	var adopted bool
+	var err error
+
+	if roleType.IsBuiltIn() {
+		response, err = r.adoptBuiltInRole(ctx, roleType, newRole)
+		adopted = true
+	} else {
+		response, adopted, err = createOrAdopt(ctx, &resp.Diagnostics, r.client.IncidentRoles().Service, r.client.shouldAdoptExisting(data.AdoptExisting), newRole,
+			func(role incidentio.IncidentRoleMetadata) string { return role.Id })
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident role, got error: %s", err))
+		addCreateError(ctx, resp, "incident role", err)
 		return
 	}
 
-	data.Id = types.StringValue(response.IncidentRole.Id)
-	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.IncidentRole.Id))
+	planned := data
+	data.fromMetadata(*response)
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.Id))
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, adopted)
 }
 
 func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -138,9 +227,19 @@ func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	roleId := data.Id.ValueString()
 
-	response, err := r.client.IncidentRoles().Get(roleId)
+	response, err := r.client.IncidentRoles().Get(ctx, roleId)
 	if incidentio.IsErrorStatus(err, 404) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -151,18 +250,17 @@ func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	data.Id = types.StringValue(response.IncidentRole.Id)
-	data.Name = types.StringValue(response.IncidentRole.Name)
-	data.Description = types.StringValue(response.IncidentRole.Description)
-	data.Required = types.BoolValue(response.IncidentRole.Required)
-	data.Instructions = types.StringValue(response.IncidentRole.Instructions)
-	data.ShortForm = types.StringValue(response.IncidentRole.ShortForm)
+	data.fromMetadata(*response)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "update", "incident role") {
+		return
+	}
+
 	var data incidentRoleData
 
 	diags := req.Plan.Get(ctx, &data)
@@ -172,6 +270,16 @@ func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	timeout, diags := data.Timeouts.Update(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	roleId := data.Id.ValueString()
 	updatedRole := incidentio.IncidentRole{
 		Name:         data.Name.ValueString(),
@@ -181,17 +289,23 @@ func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		ShortForm:    data.ShortForm.ValueString(),
 	}
 
-	_, err := r.client.IncidentRoles().Update(roleId, updatedRole)
+	response, err := r.client.IncidentRoles().Update(ctx, roleId, updatedRole)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident role, got error: %s", err))
 		return
 	}
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	planned := data
+	data.fromMetadata(*response)
+
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, false)
 }
 
 func (r *IncidentRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "delete", "incident role") {
+		return
+	}
+
 	var data incidentRoleData
 
 	diags := req.State.Get(ctx, &data)
@@ -201,7 +315,30 @@ func (r *IncidentRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.client.IncidentRoles().Delete(data.Id.ValueString())
+	timeout, diags := data.Timeouts.Delete(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if incidentio.RoleType(data.RoleType.ValueString()).IsBuiltIn() {
+		// Built-in roles can't be deleted: we only stop managing them.
+		resp.Diagnostics.AddWarning(
+			"Built-in Incident Role Not Deleted",
+			fmt.Sprintf(
+				"The %s role is built into incident.io and can't be deleted: it has only been removed from the Terraform state, "+
+					"and keeps the configuration set by Terraform, like its instructions.",
+				data.RoleType.ValueString(),
+			),
+		)
+		return
+	}
+
+	err := r.client.IncidentRoles().Delete(ctx, data.Id.ValueString())
 	if incidentio.IsErrorStatus(err, 404) {
 		// The resource is already gone.
 		return
@@ -214,5 +351,34 @@ func (r *IncidentRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *IncidentRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Built-in roles can be imported using their role type instead of their ID.
+	roleType, err := incidentio.ParseRoleType(req.ID)
+	if err == nil && roleType.IsBuiltIn() {
+		// There is no timeouts block to read during an import.
+		ctx, cancel := context.WithTimeout(ctx, r.client.defaultTimeout)
+		defer cancel()
+
+		role, err := r.client.IncidentRoles().GetByRoleType(ctx, *roleType)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the %s incident role, got error: %s", *roleType, err))
+			return
+		}
+
+		diags := resp.State.SetAttribute(ctx, path.Root("id"), role.Id)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// adoptBuiltInRole configures the existing built-in role of the specified
+// type, instead of creating a new role.
+func (r *IncidentRoleResource) adoptBuiltInRole(ctx context.Context, roleType incidentio.RoleType, role incidentio.IncidentRole) (*incidentio.IncidentRoleMetadata, error) {
+	existing, err := r.client.IncidentRoles().GetByRoleType(ctx, roleType)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.IncidentRoles().Update(ctx, existing.Id, role)
+}
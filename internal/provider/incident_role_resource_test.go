@@ -24,6 +24,9 @@ func TestAccIncidentRoleResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("incidentio_incident_role.test", "name", "role 1"),
 					resource.TestCheckResourceAttr("incidentio_incident_role.test", "required", "false"),
+					resource.TestCheckResourceAttr("incidentio_incident_role.test", "role_type", "custom"),
+					resource.TestCheckResourceAttrSet("incidentio_incident_role.test", "created_at"),
+					resource.TestCheckResourceAttrSet("incidentio_incident_role.test", "updated_at"),
 				),
 			},
 			// ImportState testing
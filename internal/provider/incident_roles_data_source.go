@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &IncidentRolesDataSource{}
+
+// incidentRoleObjectType is the type of each incident role returned by the
+// incidentio_incident_roles data source.
+var incidentRoleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.StringType,
+		"name":         types.StringType,
+		"description":  types.StringType,
+		"required":     types.BoolType,
+		"instructions": types.StringType,
+		"short_form":   types.StringType,
+		"role_type":    types.StringType,
+		"created_at":   types.StringType,
+		"updated_at":   types.StringType,
+	},
+}
+
+type incidentRolesDataSourceData struct {
+	Id            types.String `tfsdk:"id"`
+	IncidentRoles types.List   `tfsdk:"incident_roles"`
+}
+
+type IncidentRolesDataSource struct {
+	client *providerClient
+}
+
+func NewIncidentRolesDataSource() datasource.DataSource {
+	return &IncidentRolesDataSource{}
+}
+
+func (d *IncidentRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_roles"
+}
+
+func (d *IncidentRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all the incident roles",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data source",
+				Computed:            true,
+			},
+			"incident_roles": schema.ListNestedAttribute{
+				MarkdownDescription: "The incident roles",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the incident role",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the incident role",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Describes the purpose of the role",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether incident require this role to be set",
+							Computed:            true,
+						},
+						"instructions": schema.StringAttribute{
+							MarkdownDescription: "Provided to whoever is nominated for the role",
+							Computed:            true,
+						},
+						"short_form": schema.StringAttribute{
+							MarkdownDescription: "Short human readable name for Slack",
+							Computed:            true,
+						},
+						"role_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the role, one of `lead`, `reporter` or `custom`",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident role was created, in RFC3339 format",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident role was last updated, in RFC3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IncidentRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data incidentRolesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	response, err := d.client.IncidentRoles().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident roles, got error: %s", err))
+		return
+	}
+
+	roles := make([]incidentRoleDataSourceData, len(response))
+	for i, role := range response {
+		roles[i].fromMetadata(role)
+	}
+
+	data.Id = types.StringValue("incident_roles")
+
+	data.IncidentRoles, diags = types.ListValueFrom(ctx, incidentRoleObjectType, roles)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
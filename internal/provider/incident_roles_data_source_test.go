@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestIncidentRoleObjectType(t *testing.T) {
+	updatedAt := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	var role incidentRoleDataSourceData
+	role.fromMetadata(incidentio.IncidentRoleMetadata{
+		IncidentRole: incidentio.IncidentRole{Name: "Incident Lead", ShortForm: "lead"},
+		Id:           "01FCNDV6P870EA6S7TK1DSYDG0",
+		RoleType:     incidentio.RoleTypeLead,
+		UpdatedAt:    updatedAt,
+	})
+
+	assert.Equal(t, types.StringValue("lead"), role.RoleType)
+	assert.Equal(t, types.StringValue(""), role.Instructions)
+	assert.True(t, role.CreatedAt.IsNull())
+	assert.Equal(t, "2023-02-01T10:00:00Z", role.UpdatedAt.ValueString())
+
+	list, diags := types.ListValueFrom(context.Background(), incidentRoleObjectType, []incidentRoleDataSourceData{role})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, list.Elements(), 1)
+}
+
+func TestAccIncidentRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"random": {
+				VersionConstraint: "3.1.3",
+				Source:            "hashicorp/random",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentRoleResourceConfig("roles list", false) + `
+	data "incidentio_incident_roles" "all" {
+		depends_on = [incidentio_incident_role.test]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.incidentio_incident_roles.all", "incident_roles.0.id"),
+				),
+			},
+		},
+	})
+}
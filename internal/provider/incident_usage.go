@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// defaultIncidentLookbackDays is the default number of days of incidents
+// scanned before destroying a custom field or a custom field option.
+const defaultIncidentLookbackDays = 30
+
+// maxIncidentUsageExamples is the maximum number of incidents referenced in
+// the usage warnings.
+const maxIncidentUsageExamples = 5
+
+// incidentUsage describes the incidents using a custom field or a custom field option.
+type incidentUsage struct {
+	count    int
+	examples []string
+}
+
+// findIncidentsUsing looks for the incidents created since the specified time
+// with a custom field entry matching.
+func findIncidentsUsing(ctx context.Context, client *incidentio.Client, since time.Time, match func(entry incidentio.CustomFieldEntry) bool) (*incidentUsage, error) {
+	usage := &incidentUsage{}
+
+	// incident.io doesn't document the order of the incidents, so all of
+	// them are checked.
+	err := client.Incidents().ListPages(ctx, nil, func(page []incidentio.Incident) bool {
+		for _, incident := range page {
+			if incident.CreatedAt.Before(since) {
+				continue
+			}
+
+			for _, entry := range incident.CustomFieldEntries {
+				if !match(entry) {
+					continue
+				}
+
+				usage.count++
+				if len(usage.examples) < maxIncidentUsageExamples {
+					usage.examples = append(usage.examples, incident.Reference)
+				}
+				break
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// warnIncidentUsage adds a warning if the custom field or custom field
+// option described by subject is still used by recent incidents.
+func warnIncidentUsage(ctx context.Context, client *providerClient, diags *diag.Diagnostics, subject string, match func(entry incidentio.CustomFieldEntry) bool) {
+	if client.incidentLookbackDays <= 0 {
+		return
+	}
+
+	since := time.Now().AddDate(0, 0, -int(client.incidentLookbackDays))
+
+	usage, err := findIncidentsUsing(ctx, client.Client, since, match)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to check incidents",
+			fmt.Sprintf("Unable to check whether the %s is used by incidents, got error: %s", subject, err),
+		)
+		return
+	}
+
+	if usage.count == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"Destroying a value used by incidents",
+		fmt.Sprintf(
+			"The %s is used by %d incident(s) created during the last %d day(s), for example: %s. "+
+				"Destroying it will also remove it from these incidents.",
+			subject, usage.count, client.incidentLookbackDays, strings.Join(usage.examples, ", "),
+		),
+	)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func incidentJSON(reference string, createdAt time.Time, optionId string) string {
+	return fmt.Sprintf(`{
+		"id": %q,
+		"reference": %q,
+		"created_at": %q,
+		"custom_field_entries": [{
+			"custom_field": {"id": "field"},
+			"values": [{"value_option": {"id": %q}}]
+		}]
+	}`, reference, reference, createdAt.Format(time.RFC3339), optionId)
+}
+
+func newIncidentsServer(t *testing.T, requests *int) *httptest.Server {
+	now := time.Now()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		var body string
+		switch r.URL.Query().Get("after") {
+		case "":
+			body = fmt.Sprintf(`{"incidents": [%s, %s], "pagination_meta": {"after": "INC-3"}}`,
+				incidentJSON("INC-4", now, "a"), incidentJSON("INC-3", now.AddDate(0, 0, -2), "b"))
+		case "INC-3":
+			body = fmt.Sprintf(`{"incidents": [%s, %s], "pagination_meta": {"after": "INC-1"}}`,
+				incidentJSON("INC-2", now.AddDate(0, 0, -5), "a"), incidentJSON("INC-1", now.AddDate(0, 0, -40), "a"))
+		case "INC-1":
+			body = `{"incidents": []}`
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL)
+		}
+
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+	})
+
+	return httptest.NewServer(handler)
+}
+
+func TestFindIncidentsUsing(t *testing.T) {
+	requests := 0
+	server := newIncidentsServer(t, &requests)
+	defer server.Close()
+
+	client := incidentio.NewClient("foobar").WithHostURL(server.URL)
+	usesOption := func(id string) func(entry incidentio.CustomFieldEntry) bool {
+		return func(entry incidentio.CustomFieldEntry) bool {
+			return entry.Values[0].ValueOption.Id == id
+		}
+	}
+
+	usage, err := findIncidentsUsing(context.Background(), client, time.Now().AddDate(0, 0, -30), usesOption("a"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, usage.count)
+	assert.Equal(t, []string{"INC-4", "INC-2"}, usage.examples)
+
+	usage, err = findIncidentsUsing(context.Background(), client, time.Now().AddDate(0, 0, -1), usesOption("b"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, usage.count)
+}
+
+func TestWarnIncidentUsage(t *testing.T) {
+	requests := 0
+	server := newIncidentsServer(t, &requests)
+	defer server.Close()
+
+	client := &providerClient{
+		Client:               incidentio.NewClient("foobar").WithHostURL(server.URL),
+		incidentLookbackDays: 30,
+	}
+	match := func(entry incidentio.CustomFieldEntry) bool { return entry.CustomField.Id == "field" }
+
+	var diags diag.Diagnostics
+	warnIncidentUsage(context.Background(), client, &diags, `custom field "Team"`, match)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Contains(t, diags[0].Detail(), "used by 3 incident(s)")
+	assert.Contains(t, diags[0].Detail(), "INC-4, INC-3, INC-2")
+
+	requests = 0
+	client.incidentLookbackDays = 0
+	diags = nil
+	warnIncidentUsage(context.Background(), client, &diags, `custom field "Team"`, match)
+	assert.Empty(t, diags)
+	assert.Equal(t, 0, requests)
+}
@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &IncidentsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IncidentsDataSource{}
+
+// defaultMaxIncidents is the default maximum number of incidents returned by
+// the incidentio_incidents data source.
+const defaultMaxIncidents = 100
+
+// maxScannedIncidents is the maximum number of incidents scanned by the
+// incidentio_incidents data source. The mode and severity filters aren't
+// supported by the API, so the incidents are filtered while going through
+// the pages, which could otherwise go through the whole history.
+const maxScannedIncidents = 1000
+
+// incidentObjectType is the type of each incident returned by the
+// incidentio_incidents data source.
+var incidentObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                 types.StringType,
+		"reference":          types.StringType,
+		"name":               types.StringType,
+		"status":             types.StringType,
+		"mode":               types.StringType,
+		"visibility":         types.StringType,
+		"severity_id":        types.StringType,
+		"severity":           types.StringType,
+		"incident_type_id":   types.StringType,
+		"incident_type":      types.StringType,
+		"permalink":          types.StringType,
+		"slack_channel_name": types.StringType,
+		"created_at":         types.StringType,
+		"updated_at":         types.StringType,
+		"timestamps":         types.MapType{ElemType: types.StringType},
+	},
+}
+
+type incidentObject struct {
+	Id               types.String `tfsdk:"id"`
+	Reference        types.String `tfsdk:"reference"`
+	Name             types.String `tfsdk:"name"`
+	Status           types.String `tfsdk:"status"`
+	Mode             types.String `tfsdk:"mode"`
+	Visibility       types.String `tfsdk:"visibility"`
+	SeverityId       types.String `tfsdk:"severity_id"`
+	Severity         types.String `tfsdk:"severity"`
+	IncidentTypeId   types.String `tfsdk:"incident_type_id"`
+	IncidentType     types.String `tfsdk:"incident_type"`
+	Permalink        types.String `tfsdk:"permalink"`
+	SlackChannelName types.String `tfsdk:"slack_channel_name"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Timestamps       types.Map    `tfsdk:"timestamps"`
+}
+
+// fromIncident updates the object using the incident returned by incident.io.
+func (d *incidentObject) fromIncident(incident incidentio.Incident) {
+	d.Id = types.StringValue(incident.Id)
+	d.Reference = types.StringValue(incident.Reference)
+	d.Name = types.StringValue(incident.Name)
+	d.Status = types.StringValue(string(incident.Status))
+	d.Mode = types.StringValue(string(incident.Mode))
+	d.Visibility = types.StringValue(string(incident.Visibility))
+	d.Permalink = types.StringValue(incident.Permalink)
+	d.SlackChannelName = types.StringValue(incident.SlackChannelName)
+	d.CreatedAt = timeValue(incident.CreatedAt)
+	d.UpdatedAt = timeValue(incident.UpdatedAt)
+
+	timestamps := map[string]attr.Value{}
+	for _, timestamp := range incident.Timestamps {
+		if timestamp.LastOccurredAt != nil {
+			timestamps[timestamp.Name] = timeValue(*timestamp.LastOccurredAt)
+		}
+	}
+	d.Timestamps = types.MapValueMust(types.StringType, timestamps)
+
+	d.SeverityId = types.StringNull()
+	d.Severity = types.StringNull()
+	if incident.Severity != nil {
+		d.SeverityId = types.StringValue(incident.Severity.Id)
+		d.Severity = types.StringValue(incident.Severity.Name)
+	}
+
+	d.IncidentTypeId = types.StringNull()
+	d.IncidentType = types.StringNull()
+	if incident.IncidentType != nil {
+		d.IncidentTypeId = types.StringValue(incident.IncidentType.Id)
+		d.IncidentType = types.StringValue(incident.IncidentType.Name)
+	}
+}
+
+type incidentsDataSourceData struct {
+	Id         types.String `tfsdk:"id"`
+	Status     types.List   `tfsdk:"status"`
+	Mode       types.String `tfsdk:"mode"`
+	Severity   types.String `tfsdk:"severity"`
+	MaxResults types.Int64  `tfsdk:"max_results"`
+	Incidents  types.List   `tfsdk:"incidents"`
+}
+
+// matcher returns a function matching the incidents selected by the filters
+// of the data source. The statuses are also sent to the API, but they are
+// checked again here.
+func (d *incidentsDataSourceData) matcher(statuses []string) func(incident incidentio.Incident) bool {
+	wantedStatuses := map[string]bool{}
+	for _, status := range statuses {
+		wantedStatuses[status] = true
+	}
+
+	return func(incident incidentio.Incident) bool {
+		if len(wantedStatuses) > 0 && !wantedStatuses[string(incident.Status)] {
+			return false
+		}
+
+		if !d.Mode.IsNull() && string(incident.Mode) != d.Mode.ValueString() {
+			return false
+		}
+
+		if !d.Severity.IsNull() {
+			severity := d.Severity.ValueString()
+			if incident.Severity == nil || (incident.Severity.Id != severity && incident.Severity.Name != severity) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+type IncidentsDataSource struct {
+	client *providerClient
+}
+
+func NewIncidentsDataSource() datasource.DataSource {
+	return &IncidentsDataSource{}
+}
+
+func (d *IncidentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incidents"
+}
+
+func (d *IncidentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the incidents, from the most recent one, optionally filtered by status, mode or severity",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data source",
+				Computed:            true,
+			},
+			"status": schema.ListAttribute{
+				MarkdownDescription: "Only return the incidents with one of these statuses. " +
+					"Each status must be one of `triage`, `investigating`, `fixing`, `monitoring`, `closed` or `declined`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Only return the incidents of this mode. Must be one of `real`, `test` or `tutorial`. " +
+					fmt.Sprintf("This is filtered by the provider, within the first %d incidents matching the status, with a warning if there are more.", maxScannedIncidents),
+				Optional: true,
+				Validators: []validator.String{
+					isValidIncidentMode(),
+				},
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Only return the incidents with this severity, either its ID or its name. " +
+					fmt.Sprintf("This is filtered by the provider, within the first %d incidents matching the status, with a warning if there are more.", maxScannedIncidents),
+				Optional: true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of incidents to return. Defaults to `%d`.", defaultMaxIncidents),
+				Optional:            true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching incidents, from the most recent one",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the incident",
+							Computed:            true,
+						},
+						"reference": schema.StringAttribute{
+							MarkdownDescription: "Human readable reference of the incident, like `INC-123`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Explanation of the incident",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current status of the incident",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							MarkdownDescription: "Whether the incident is real, a test or a tutorial",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							MarkdownDescription: "Whether the incident is public or private",
+							Computed:            true,
+						},
+						"severity_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the severity of the incident",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Name of the severity of the incident",
+							Computed:            true,
+						},
+						"incident_type_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the type of the incident",
+							Computed:            true,
+						},
+						"incident_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type of the incident",
+							Computed:            true,
+						},
+						"permalink": schema.StringAttribute{
+							MarkdownDescription: "Link to the incident in the incident.io dashboard",
+							Computed:            true,
+						},
+						"slack_channel_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Slack channel of the incident",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident was created, in RFC3339 format",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident was last updated, in RFC3339 format",
+							Computed:            true,
+						},
+						"timestamps": schema.MapAttribute{
+							MarkdownDescription: "The incident timestamps which occurred, in RFC3339 format and indexed by name, like `Reported at`",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IncidentsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data incidentsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MaxResults.IsNull() && !data.MaxResults.IsUnknown() && data.MaxResults.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_results"),
+			"Invalid Maximum Number of Results",
+			fmt.Sprintf("The maximum number of incidents must be positive, got: %d.", data.MaxResults.ValueInt64()),
+		)
+	}
+
+	if data.Status.IsNull() || data.Status.IsUnknown() {
+		return
+	}
+
+	for i, element := range data.Status.Elements() {
+		validation := validator.StringResponse{}
+		isValidIncidentStatus().ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("status").AtListIndex(i),
+			ConfigValue: element.(types.String),
+		}, &validation)
+		resp.Diagnostics.Append(validation.Diagnostics...)
+	}
+}
+
+func (d *IncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data incidentsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []string
+	if !data.Status.IsNull() {
+		diags = data.Status.ElementsAs(ctx, &statuses, false)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	maxResults := int64(defaultMaxIncidents)
+	if !data.MaxResults.IsNull() {
+		maxResults = data.MaxResults.ValueInt64()
+	}
+
+	match := data.matcher(statuses)
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	params := url.Values{}
+	for _, status := range statuses {
+		params.Add("status", status)
+	}
+
+	incidents := []incidentObject{}
+	scanned := 0
+	truncated := false
+
+	err := d.client.Incidents().ListPages(ctx, params, func(page []incidentio.Incident) bool {
+		scanned += len(page)
+
+		for _, incident := range filter(page, match) {
+			var object incidentObject
+			object.fromIncident(incident)
+			incidents = append(incidents, object)
+
+			if int64(len(incidents)) >= maxResults {
+				return false
+			}
+		}
+
+		if scanned >= maxScannedIncidents {
+			truncated = true
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incidents, got error: %s", err))
+		return
+	}
+
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"Incidents Not Fully Scanned",
+			fmt.Sprintf("Stopped looking for matching incidents after scanning %d incidents, older incidents are not returned.", scanned),
+		)
+	}
+
+	data.Id = types.StringValue("incidents")
+
+	data.Incidents, diags = types.ListValueFrom(ctx, incidentObjectType, incidents)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestIncidentsDataSourceMatcher(t *testing.T) {
+	major := incidentio.SeverityMetadata{
+		Severity: incidentio.Severity{Name: "Major", Rank: 3},
+		Id:       "01FCNDV6P870EA6S7TK1DSYDG0",
+	}
+
+	outage := incidentio.Incident{Status: incidentio.IncidentStatusFixing, Mode: incidentio.IncidentModeReal, Severity: &major}
+	drill := incidentio.Incident{Status: incidentio.IncidentStatusClosed, Mode: incidentio.IncidentModeTest}
+
+	data := incidentsDataSourceData{
+		Mode:     types.StringNull(),
+		Severity: types.StringNull(),
+	}
+
+	match := data.matcher(nil)
+	assert.True(t, match(outage))
+	assert.True(t, match(drill))
+
+	match = data.matcher([]string{"triage", "fixing"})
+	assert.True(t, match(outage))
+	assert.False(t, match(drill))
+
+	data.Mode = types.StringValue("test")
+	match = data.matcher(nil)
+	assert.False(t, match(outage))
+	assert.True(t, match(drill))
+
+	data.Mode = types.StringNull()
+	data.Severity = types.StringValue("Major")
+	match = data.matcher(nil)
+	assert.True(t, match(outage))
+	assert.False(t, match(drill))
+
+	data.Severity = types.StringValue(major.Id)
+	match = data.matcher(nil)
+	assert.True(t, match(outage))
+	assert.False(t, match(drill))
+}
+
+func TestIncidentObjectType(t *testing.T) {
+	reportedAt := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	var triaged, declared incidentObject
+	triaged.fromIncident(incidentio.Incident{
+		Id:        "1",
+		Reference: "INC-1",
+		Timestamps: []incidentio.IncidentTimestamp{
+			{Name: "Reported at", LastOccurredAt: &reportedAt},
+			{Name: "Resolved at"},
+		},
+	})
+	declared.fromIncident(incidentio.Incident{
+		Id:        "2",
+		Reference: "INC-2",
+		Severity:  &incidentio.SeverityMetadata{Id: "01FCNDV6P870EA6S7TK1DSYDG0", Severity: incidentio.Severity{Name: "Minor"}},
+		CreatedAt: reportedAt,
+	})
+
+	assert.True(t, triaged.SeverityId.IsNull())
+	assert.True(t, triaged.Severity.IsNull())
+	assert.True(t, triaged.IncidentType.IsNull())
+	assert.True(t, triaged.CreatedAt.IsNull())
+	assert.Equal(t, map[string]attr.Value{"Reported at": types.StringValue("2023-02-01T10:00:00Z")}, triaged.Timestamps.Elements())
+	assert.Equal(t, types.StringValue("01FCNDV6P870EA6S7TK1DSYDG0"), declared.SeverityId)
+	assert.Equal(t, types.StringValue("Minor"), declared.Severity)
+	assert.Equal(t, "2023-02-01T10:00:00Z", declared.CreatedAt.ValueString())
+	assert.Empty(t, declared.Timestamps.Elements())
+
+	list, diags := types.ListValueFrom(context.Background(), incidentObjectType, []incidentObject{triaged, declared})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, list.Elements(), 2)
+}
+
+func TestAccIncidentsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	data "incidentio_incidents" "recent" {
+		status      = ["closed"]
+		max_results = 2
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.incidentio_incidents.recent", "id", "incidents"),
+					resource.TestCheckResourceAttrSet("data.incidentio_incidents.recent", "incidents.#"),
+				),
+			},
+		},
+	})
+}
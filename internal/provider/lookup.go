@@ -0,0 +1,13 @@
+package provider
+
+// filter returns the objects matching.
+func filter[T any](objects []T, match func(T) bool) []T {
+	var found []T
+	for _, object := range objects {
+		if match(object) {
+			found = append(found, object)
+		}
+	}
+
+	return found
+}
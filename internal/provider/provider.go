@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/multani/terraform-provider-incidentio/incidentio"
@@ -18,16 +23,64 @@ var _ provider.Provider = &IncidentIOProvider{}
 
 // IncidentIOProvider satisfies the provider.Provider interface and usually is included
 // with all Resource and DataSource implementations.
-type IncidentIOProvider struct{}
+type IncidentIOProvider struct {
+	// version is the version of the provider, set at build time.
+	version string
+}
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey        types.String `tfsdk:"api_key"`
+	ApiKeyFile    types.String `tfsdk:"api_key_file"`
+	ApiKeyCommand types.String `tfsdk:"api_key_command"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	ReadOnly      types.Bool   `tfsdk:"read_only"`
+	Timeout       types.String `tfsdk:"timeout"`
+
+	IncidentLookbackDays types.Int64 `tfsdk:"incident_lookback_days"`
+}
+
+// defaultTimeout is the maximum duration of an operation on incident.io,
+// unless configured otherwise on the provider or on the resource.
+const defaultTimeout = 5 * time.Minute
+
+// providerClient is passed to the resources and data sources once the
+// provider has been configured.
+type providerClient struct {
+	*incidentio.Client
+
+	// adoptExisting is the default behavior of the resources when they are
+	// created while an object with the same name already exists.
+	adoptExisting bool
+
+	// incidentLookbackDays is the number of days of incidents checked before
+	// destroying custom fields and custom field options.
+	incidentLookbackDays int64
+
+	// readOnly prevents the resources from being created, updated or deleted.
+	readOnly bool
+
+	// defaultTimeout is the maximum duration of the operations on the
+	// resources without timeouts.
+	defaultTimeout time.Duration
+}
+
+// shouldAdoptExisting returns whether a resource should adopt an existing
+// object, using the resource configuration if set or the provider one
+// otherwise.
+func (c *providerClient) shouldAdoptExisting(adoptExisting types.Bool) bool {
+	if adoptExisting.IsNull() || adoptExisting.IsUnknown() {
+		return c.adoptExisting
+	}
+
+	return adoptExisting.ValueBool()
 }
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
-		return &IncidentIOProvider{}
+		return &IncidentIOProvider{
+			version: version,
+		}
 	}
 }
 
@@ -39,9 +92,43 @@ func (p *IncidentIOProvider) Schema(ctx context.Context, req provider.SchemaRequ
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key. You can also set the `INCIDENT_IO_API_KEY` environment variable instead.",
+				MarkdownDescription: "API key. You can also set the `INCIDENT_IO_API_KEY` environment variable instead. " +
+					"Only one of `api_key`, `api_key_file` or `api_key_command` can be set, and they take precedence over the environment variable.",
+				Optional: true,
+			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file containing the API key. Leading and trailing whitespaces are ignored.",
 				Optional:            true,
 			},
+			"api_key_command": schema.StringAttribute{
+				MarkdownDescription: "Shell command printing the API key on its standard output, " +
+					"like `vault kv get -field=api_key secret/incident-io`. Leading and trailing whitespaces are ignored.",
+				Optional: true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether resources should adopt the existing objects with the same name, " +
+					"instead of failing to create new ones. This can be overridden on each resource. Defaults to `false`.",
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the provider from making any changes to incident.io: only read requests are sent, " +
+					"and creating, updating or deleting resources fails. You can also set the `INCIDENT_IO_READ_ONLY` environment variable instead. " +
+					"Defaults to `false`.",
+				Optional: true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for each operation on incident.io, and for `api_key_command`, as a duration like `30s` or `2m`. " +
+					"This can be overridden with the `timeouts` block of each resource. Defaults to `5m`.",
+				Optional: true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"incident_lookback_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days of incidents checked when planning to destroy a custom field or a custom field option, " +
+					"to warn if they are still used by some incidents. Set to `0` to disable the check. Defaults to `30`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -58,18 +145,91 @@ func (p *IncidentIOProvider) Configure(ctx context.Context, req provider.Configu
 	// If the upstream provider SDK or HTTP client requires configuration, such
 	// as authentication or logging, this is a great opportunity to do so.
 
-	var apiKey string
-	if data.ApiKey.IsNull() {
-		apiKey = os.Getenv("INCIDENT_IO_API_KEY")
-	} else {
-		apiKey = data.ApiKey.ValueString()
+	timeout := defaultTimeout
+	if !data.Timeout.IsNull() {
+		var err error
+		timeout, err = time.ParseDuration(data.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("Unable to parse the timeout, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	// The API key command may wait for a login or the network: it is bounded
+	// like the operations on incident.io.
+	apiKeyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	apiKey, diags := resolveApiKey(apiKeyCtx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incidentLookbackDays := int64(defaultIncidentLookbackDays)
+	if !data.IncidentLookbackDays.IsNull() {
+		incidentLookbackDays = data.IncidentLookbackDays.ValueInt64()
+	}
+
+	if incidentLookbackDays < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("incident_lookback_days"),
+			"Invalid incident lookback",
+			fmt.Sprintf("The number of days of incidents to check can't be negative, got: %d.", incidentLookbackDays),
+		)
+		return
+	}
+
+	readOnly := data.ReadOnly.ValueBool()
+	if data.ReadOnly.IsNull() {
+		if value, ok := os.LookupEnv("INCIDENT_IO_READ_ONLY"); ok && value != "" {
+			var err error
+			readOnly, err = strconv.ParseBool(value)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("read_only"),
+					"Invalid read-only mode",
+					fmt.Sprintf("The INCIDENT_IO_READ_ONLY environment variable must be a boolean, got: %q.", value),
+				)
+				return
+			}
+		}
 	}
 
-	client := incidentio.NewClient(apiKey)
+	// TF_APPEND_USER_AGENT is the usual way to identify the tools running
+	// Terraform in the requests made by providers.
+	apiClient := incidentio.NewClient(apiKey).
+		WithReadOnly(readOnly).
+		WithUserAgent(userAgent(p.version, req.TerraformVersion)).
+		AppendUserAgent(os.Getenv("TF_APPEND_USER_AGENT"))
+
+	client := &providerClient{
+		Client:               apiClient,
+		adoptExisting:        data.AdoptExisting.ValueBool(),
+		incidentLookbackDays: incidentLookbackDays,
+		readOnly:             readOnly,
+		defaultTimeout:       timeout,
+	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// userAgent returns the User-Agent header identifying the provider and
+// Terraform versions.
+func userAgent(version string, terraformVersion string) string {
+	userAgent := fmt.Sprintf("%s/%s", incidentio.DefaultUserAgent, version)
+	if terraformVersion != "" {
+		userAgent += fmt.Sprintf(" (+terraform %s)", terraformVersion)
+	}
+
+	return userAgent
+}
+
 func (p *IncidentIOProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCustomFieldOptionResource,
@@ -80,5 +240,17 @@ func (p *IncidentIOProvider) Resources(ctx context.Context) []func() resource.Re
 }
 
 func (p *IncidentIOProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewActionsDataSource,
+		NewCustomFieldDataSource,
+		NewCustomFieldOptionsDataSource,
+		NewCustomFieldsDataSource,
+		NewIdentityDataSource,
+		NewIncidentDataSource,
+		NewIncidentRoleDataSource,
+		NewIncidentRolesDataSource,
+		NewIncidentsDataSource,
+		NewSeveritiesDataSource,
+		NewSeverityDataSource,
+	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -25,3 +26,8 @@ func testAccPreCheck(t *testing.T) {
 		t.Skip("No incident.io API key present, skipping test")
 	}
 }
+
+func TestUserAgent(t *testing.T) {
+	assert.Equal(t, "terraform-provider-incidentio/1.2.3 (+terraform 1.4.0)", userAgent("1.2.3", "1.4.0"))
+	assert.Equal(t, "terraform-provider-incidentio/dev", userAgent("dev", ""))
+}
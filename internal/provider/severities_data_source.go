@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SeveritiesDataSource{}
+
+// severityObjectType is the type of each severity returned by the
+// incidentio_severities data source.
+var severityObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"rank":        types.Int64Type,
+		"created_at":  types.StringType,
+		"updated_at":  types.StringType,
+	},
+}
+
+type severitiesDataSourceData struct {
+	Id         types.String `tfsdk:"id"`
+	Severities types.List   `tfsdk:"severities"`
+	ByName     types.Map    `tfsdk:"by_name"`
+	ByRank     types.Map    `tfsdk:"by_rank"`
+}
+
+// setSeverities updates the severities, ordered by rank, and their indexes.
+//
+// Several severities with the same name or rank can't be indexed by name or
+// rank, so this returns an error instead of keeping only one of them.
+func (d *severitiesDataSourceData) setSeverities(ctx context.Context, response []incidentio.SeverityMetadata) diag.Diagnostics {
+	var diags, moreDiags diag.Diagnostics
+
+	sort.SliceStable(response, func(i, j int) bool {
+		return response[i].Rank < response[j].Rank
+	})
+
+	severities := make([]severityDataSourceData, len(response))
+	byName := map[string]string{}
+	byRank := map[string]string{}
+
+	for i, severity := range response {
+		if id, ok := byName[severity.Name]; ok {
+			diags.AddError(
+				"Duplicate Severity Name",
+				fmt.Sprintf("The severities %s and %s are both named %q, they can't be indexed by name.", id, severity.Id, severity.Name),
+			)
+			return diags
+		}
+
+		rank := strconv.FormatInt(severity.Rank, 10)
+		if id, ok := byRank[rank]; ok {
+			diags.AddError(
+				"Duplicate Severity Rank",
+				fmt.Sprintf("The severities %s and %s both have the rank %s, they can't be indexed by rank.", id, severity.Id, rank),
+			)
+			return diags
+		}
+
+		severities[i].fromMetadata(severity)
+		byName[severity.Name] = severity.Id
+		byRank[rank] = severity.Id
+	}
+
+	d.Severities, moreDiags = types.ListValueFrom(ctx, severityObjectType, severities)
+	diags.Append(moreDiags...)
+
+	d.ByName, moreDiags = types.MapValueFrom(ctx, types.StringType, byName)
+	diags.Append(moreDiags...)
+
+	d.ByRank, moreDiags = types.MapValueFrom(ctx, types.StringType, byRank)
+	diags.Append(moreDiags...)
+
+	return diags
+}
+
+type SeveritiesDataSource struct {
+	client *providerClient
+}
+
+func NewSeveritiesDataSource() datasource.DataSource {
+	return &SeveritiesDataSource{}
+}
+
+func (d *SeveritiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_severities"
+}
+
+func (d *SeveritiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all the severities, ordered by rank",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data source",
+				Computed:            true,
+			},
+			"severities": schema.ListNestedAttribute{
+				MarkdownDescription: "The severities, from the least to the most severe",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the severity",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the severity",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the severity",
+							Computed:            true,
+						},
+						"rank": schema.Int64Attribute{
+							MarkdownDescription: "Rank of the severity (lower numbers are less severe)",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the severity was created, in RFC3339 format",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the severity was last updated, in RFC3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"by_name": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the severities, indexed by name. Reading the data source fails if several severities have the same name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"by_rank": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the severities, indexed by rank. Reading the data source fails if several severities have the same rank.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SeveritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SeveritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data severitiesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	response, err := d.client.Severities().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list severities, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("severities")
+
+	resp.Diagnostics.Append(data.setSeverities(ctx, response)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
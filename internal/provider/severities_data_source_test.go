@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+func TestSeverityObjectType(t *testing.T) {
+	createdAt := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	var severity severityDataSourceData
+	severity.fromMetadata(incidentio.SeverityMetadata{
+		Severity:  incidentio.Severity{Name: "Minor", Rank: 1},
+		Id:        "01FCNDV6P870EA6S7TK1DSYDG0",
+		CreatedAt: createdAt,
+	})
+
+	assert.Equal(t, types.Int64Value(1), severity.Rank)
+	assert.Equal(t, "2023-02-01T10:00:00Z", severity.CreatedAt.ValueString())
+	assert.True(t, severity.UpdatedAt.IsNull())
+
+	list, diags := types.ListValueFrom(context.Background(), severityObjectType, []severityDataSourceData{severity})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, list.Elements(), 1)
+}
+
+func TestSeveritiesDataSourceSetSeverities(t *testing.T) {
+	var data severitiesDataSourceData
+
+	diags := data.setSeverities(context.Background(), []incidentio.SeverityMetadata{
+		{Id: "2", Severity: incidentio.Severity{Name: "Major", Rank: 2}},
+		{Id: "1", Severity: incidentio.Severity{Name: "Minor", Rank: 1}},
+	})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, data.Severities.Elements(), 2)
+	assert.Equal(t, `"2"`, data.ByName.Elements()["Major"].String())
+	assert.Equal(t, `"1"`, data.ByRank.Elements()["1"].String())
+
+	diags = data.setSeverities(context.Background(), []incidentio.SeverityMetadata{
+		{Id: "1", Severity: incidentio.Severity{Name: "Minor", Rank: 1}},
+		{Id: "2", Severity: incidentio.Severity{Name: "Minor", Rank: 2}},
+	})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Duplicate Severity Name", diags[0].Summary())
+
+	diags = data.setSeverities(context.Background(), []incidentio.SeverityMetadata{
+		{Id: "1", Severity: incidentio.Severity{Name: "Minor", Rank: 1}},
+		{Id: "2", Severity: incidentio.Severity{Name: "Major", Rank: 1}},
+	})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Duplicate Severity Rank", diags[0].Summary())
+}
+
+func TestAccSeveritiesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSeverityResourceConfig("sev ladder", 41) + `
+	data "incidentio_severities" "all" {
+		depends_on = [incidentio_severity.test]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.incidentio_severities.all", "severities.0.id"),
+					resource.TestCheckResourceAttrPair("data.incidentio_severities.all", "by_name.sev ladder", "incidentio_severity.test", "id"),
+					resource.TestCheckResourceAttrPair("data.incidentio_severities.all", "by_rank.41", "incidentio_severity.test", "id"),
+				),
+			},
+		},
+	})
+}
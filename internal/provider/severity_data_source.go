@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/multani/terraform-provider-incidentio/incidentio"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SeverityDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &SeverityDataSource{}
+
+type severityDataSourceData struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Rank        types.Int64  `tfsdk:"rank"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// fromMetadata updates the data using the severity returned by incident.io.
+func (d *severityDataSourceData) fromMetadata(severity incidentio.SeverityMetadata) {
+	d.Id = types.StringValue(severity.Id)
+	d.Name = types.StringValue(severity.Name)
+	d.Description = types.StringValue(severity.Description)
+	d.Rank = types.Int64Value(severity.Rank)
+	d.CreatedAt = timeValue(severity.CreatedAt)
+	d.UpdatedAt = timeValue(severity.UpdatedAt)
+}
+
+type SeverityDataSource struct {
+	client *providerClient
+}
+
+func NewSeverityDataSource() datasource.DataSource {
+	return &SeverityDataSource{}
+}
+
+func (d *SeverityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_severity"
+}
+
+func (d *SeverityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a severity by ID, name or rank. Exactly one of `id`, `name` or `rank` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the severity",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Human readable name of the severity",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rank": schema.Int64Attribute{
+				MarkdownDescription: "Rank of the severity (lower numbers are less severe)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the severity",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the severity was created, in RFC3339 format",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the severity was last updated, in RFC3339 format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SeverityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SeverityDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data severityDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkExactlyOneOf(map[string]attr.Value{
+		"id":   data.Id,
+		"name": data.Name,
+		"rank": data.Rank,
+	})...)
+}
+
+func (d *SeverityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data severityDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.defaultTimeout)
+	defer cancel()
+
+	if !data.Id.IsNull() {
+		severity, err := d.client.Severities().Get(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get severity, got error: %s", err))
+			return
+		}
+
+		data.fromMetadata(*severity)
+
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	severities, err := d.client.Severities().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list severities, got error: %s", err))
+		return
+	}
+
+	criteria := fmt.Sprintf("rank %d", data.Rank.ValueInt64())
+	match := func(severity incidentio.SeverityMetadata) bool {
+		return severity.Rank == data.Rank.ValueInt64()
+	}
+
+	if !data.Name.IsNull() {
+		criteria = fmt.Sprintf("name %q", data.Name.ValueString())
+		match = func(severity incidentio.SeverityMetadata) bool {
+			return severity.Name == data.Name.ValueString()
+		}
+	}
+
+	found := filter(severities, match)
+
+	if len(found) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to Find Severity",
+			fmt.Sprintf("Expected exactly one severity with %s, found %d.", criteria, len(found)),
+		)
+		return
+	}
+
+	data.fromMetadata(found[0])
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSeverityDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSeverityResourceConfig("sev lookup", 31) + `
+	data "incidentio_severity" "by_id" {
+		id = incidentio_severity.test.id
+	}
+
+	data "incidentio_severity" "by_name" {
+		name = incidentio_severity.test.name
+	}
+
+	data "incidentio_severity" "by_rank" {
+		rank = incidentio_severity.test.rank
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.incidentio_severity.by_id", "name", "incidentio_severity.test", "name"),
+					resource.TestCheckResourceAttrPair("data.incidentio_severity.by_name", "id", "incidentio_severity.test", "id"),
+					resource.TestCheckResourceAttrPair("data.incidentio_severity.by_rank", "id", "incidentio_severity.test", "id"),
+					resource.TestCheckResourceAttr("data.incidentio_severity.by_name", "description", "A description"),
+					resource.TestCheckResourceAttrSet("data.incidentio_severity.by_name", "created_at"),
+				),
+			},
+			{
+				Config: `
+	data "incidentio_severity" "missing" {
+		name = "this severity doesn't exist"
+	}
+`,
+				ExpectError: regexp.MustCompile("Expected exactly one severity"),
+			},
+			{
+				Config: `
+	data "incidentio_severity" "conflicting" {
+		name = "sev"
+		rank = 1
+	}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of"),
+			},
+		},
+	})
+}
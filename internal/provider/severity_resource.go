@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,17 +21,32 @@ var _ resource.Resource = &SeverityResource{}
 var _ resource.ResourceWithImportState = &SeverityResource{}
 
 type severityData struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Rank        types.Int64  `tfsdk:"rank"`
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	Rank               types.Int64    `tfsdk:"rank"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// fromMetadata updates the data using the severity returned by incident.io.
+func (d *severityData) fromMetadata(severity incidentio.SeverityMetadata) {
+	d.Id = types.StringValue(severity.Id)
+	d.Name = types.StringValue(severity.Name)
+	d.Description = types.StringValue(severity.Description)
+	d.Rank = types.Int64Value(severity.Rank)
+	d.CreatedAt = timeValue(severity.CreatedAt)
+	d.UpdatedAt = timeValue(severity.UpdatedAt)
 }
 
 type SeverityResource struct {
 	// client is the SDK used to communicate with the incident.io service.
 	// Resource and DataSource implementations can then make calls using this
 	// client.
-	client *incidentio.Client
+	client *providerClient
 }
 
 func NewSeverityResource() resource.Resource {
@@ -53,6 +69,17 @@ func (r *SeverityResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the severity was created, in RFC3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the severity was last updated, in RFC3339 format",
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Human readable name of the severity",
 				Required:            true,
@@ -71,6 +98,29 @@ func (r *SeverityResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "Rank to help sort severities (lower numbers are less severe)",
 				Required:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt the existing severity with the same name instead of creating a new one. " +
+					"Defaults to the `adopt_existing` setting of the provider.",
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from deleting the severity, which would also remove it from all the past incidents. " +
+					"It must be set to `false` and applied before the severity can be destroyed. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefaultValue(true),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -81,12 +131,12 @@ func (r *SeverityResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*incidentio.Client)
+	client, ok := req.ProviderData.(*providerClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *incidentio.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -96,6 +146,10 @@ func (r *SeverityResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *SeverityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "create", "severity") {
+		return
+	}
+
 	var data severityData
 
 	diags := req.Plan.Get(ctx, &data)
@@ -105,22 +159,33 @@ func (r *SeverityResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	timeout, diags := data.Timeouts.Create(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	newSeverity := incidentio.Severity{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Rank:        data.Rank.ValueInt64(),
 	}
-	response, err := r.client.Severities().Create(newSeverity)
+	response, adopted, err := createOrAdopt(ctx, &resp.Diagnostics, r.client.Severities().Service, r.client.shouldAdoptExisting(data.AdoptExisting), newSeverity,
+		func(severity incidentio.SeverityMetadata) string { return severity.Id })
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create severity, got error: %s", err))
+		addCreateError(ctx, resp, "severity", err)
 		return
 	}
 
-	data.Id = types.StringValue(response.Severity.Id)
-	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.Severity.Id))
+	planned := data
+	data.fromMetadata(*response)
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID=%s", response.Id))
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, adopted)
 }
 
 func (r *SeverityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -133,9 +198,19 @@ func (r *SeverityResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	severityId := data.Id.ValueString()
 
-	response, err := r.client.Severities().Get(severityId)
+	response, err := r.client.Severities().Get(ctx, severityId)
 	if incidentio.IsErrorStatus(err, 404) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -146,16 +221,23 @@ func (r *SeverityResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	data.Id = types.StringValue(response.Severity.Id)
-	data.Name = types.StringValue(response.Severity.Name)
-	data.Description = types.StringValue(response.Severity.Description)
-	data.Rank = types.Int64Value(response.Severity.Rank)
+	data.fromMetadata(*response)
+
+	// Resources imported or created before deletion protection existed are
+	// protected by default.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(true)
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *SeverityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "update", "severity") {
+		return
+	}
+
 	var data severityData
 
 	diags := req.Plan.Get(ctx, &data)
@@ -165,6 +247,16 @@ func (r *SeverityResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	timeout, diags := data.Timeouts.Update(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	severityId := data.Id.ValueString()
 	updatedSeverity := incidentio.Severity{
 		Name:        data.Name.ValueString(),
@@ -172,17 +264,23 @@ func (r *SeverityResource) Update(ctx context.Context, req resource.UpdateReques
 		Rank:        data.Rank.ValueInt64(),
 	}
 
-	_, err := r.client.Severities().Update(severityId, updatedSeverity)
+	response, err := r.client.Severities().Update(ctx, severityId, updatedSeverity)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update severity, got error: %s", err))
 		return
 	}
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	planned := data
+	data.fromMetadata(*response)
+
+	setAppliedState(ctx, &resp.State, &resp.Diagnostics, &planned, &data, false)
 }
 
 func (r *SeverityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkWritable(r.client, &resp.Diagnostics, "delete", "severity") {
+		return
+	}
+
 	var data severityData
 
 	diags := req.State.Get(ctx, &data)
@@ -192,7 +290,28 @@ func (r *SeverityResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.Severities().Delete(data.Id.ValueString())
+	timeout, diags := data.Timeouts.Delete(ctx, r.client.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("The severity %q (%s) can't be deleted because its deletion protection is enabled. "+
+				"Deleting it would also remove it from all the past incidents. "+
+				"Set `deletion_protection = false` and apply this change first if you really want to delete it.",
+				data.Name.ValueString(), data.Id.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.Severities().Delete(ctx, data.Id.ValueString())
 	if incidentio.IsErrorStatus(err, 404) {
 		// The resource is already gone.
 		return
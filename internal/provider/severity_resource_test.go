@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -24,6 +25,8 @@ func TestAccSeverityResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("incidentio_severity.test", "name", "sev 1"),
 					resource.TestCheckResourceAttr("incidentio_severity.test", "rank", "21"),
+					resource.TestCheckResourceAttrSet("incidentio_severity.test", "created_at"),
+					resource.TestCheckResourceAttrSet("incidentio_severity.test", "updated_at"),
 				),
 			},
 			// ImportState testing
@@ -35,7 +38,7 @@ func TestAccSeverityResource(t *testing.T) {
 				// example code does not have an actual upstream service.
 				// Once the Read method is able to refresh information from
 				// the upstream service, this can be removed.
-				ImportStateVerifyIgnore: []string{"name"},
+				ImportStateVerifyIgnore: []string{"name", "deletion_protection"},
 			},
 			// Update and Read testing
 			{
@@ -51,11 +54,45 @@ func TestAccSeverityResource(t *testing.T) {
 }
 
 func testAccSeverityResourceConfig(name string, rank int) string {
+	return testAccSeverityResourceConfigWithProtection(name, rank, false)
+}
+
+func testAccSeverityResourceConfigWithProtection(name string, rank int, protected bool) string {
 	return fmt.Sprintf(`
 	resource "incidentio_severity" "test" {
 		name         = "%s"
 		description  = "A description"
 		rank 		 = %d
+
+		deletion_protection = %v
 	}
-`, name, rank)
+`, name, rank, protected)
+}
+
+// TestAccSeverityDeletionProtection tests a protected severity can't be
+// destroyed until its protection is disabled.
+func TestAccSeverityDeletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSeverityResourceConfigWithProtection("sev protected", 23, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("incidentio_severity.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      `# nothing`,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccSeverityResourceConfigWithProtection("sev protected", 23, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("incidentio_severity.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
 }
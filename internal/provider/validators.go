@@ -3,7 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -61,21 +67,35 @@ func (v stringLengthBetweenValidator) ValidateString(ctx context.Context, req va
 	}
 }
 
-type fieldTypeValidator struct{}
+// enumValidator checks a string is one of the values of an incident.io enumeration.
+type enumValidator[T ~string] struct {
+	Name   string
+	Values []T
+	Parse  func(string) (*T, error)
+}
+
+func (v enumValidator[T]) describe(quote string) string {
+	quoted := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		quoted[i] = quote + string(value) + quote
+	}
 
-func isValidCustomFieldFieldType() fieldTypeValidator {
-	return fieldTypeValidator{}
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
 }
 
-func (v fieldTypeValidator) Description(ctx context.Context) string {
-	return "field type must be one of 'single_select', 'multi_select', 'text', 'link' or 'numeric'"
+func (v enumValidator[T]) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s must be one of %s", v.Name, v.describe("'"))
 }
 
-func (v fieldTypeValidator) MarkdownDescription(ctx context.Context) string {
-	return "field type must be one of `single_select`, `multi_select`, `text`, `link` or `numeric`"
+func (v enumValidator[T]) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("%s must be one of %s", v.Name, v.describe("`"))
 }
 
-func (v fieldTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+func (v enumValidator[T]) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 	var str types.String
 	diags := tfsdk.ValueAs(ctx, req.ConfigValue, &str)
 	resp.Diagnostics.Append(diags...)
@@ -87,50 +107,157 @@ func (v fieldTypeValidator) ValidateString(ctx context.Context, req validator.St
 		return
 	}
 
-	_, err := incidentio.ParseFieldType(str.ValueString())
+	_, err := v.Parse(str.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
-			"Invalid field type",
-			"A field type must be one of 'single_select', 'multi_select', 'text', 'link' or 'numeric'.",
+			fmt.Sprintf("Invalid %s", v.Name),
+			fmt.Sprintf("The %s must be one of %s, got: %q.", v.Name, v.describe("'"), str.ValueString()),
 		)
 		return
 	}
 }
 
-type fieldRequirementValidator struct{}
+func isValidCustomFieldFieldType() enumValidator[incidentio.FieldType] {
+	return enumValidator[incidentio.FieldType]{
+		Name:   "field type",
+		Values: incidentio.FieldTypes(),
+		Parse:  incidentio.ParseFieldType,
+	}
+}
 
-func isValidCustomFieldRequired() fieldRequirementValidator {
-	return fieldRequirementValidator{}
+func isValidCustomFieldRequired() enumValidator[incidentio.FieldRequirement] {
+	return enumValidator[incidentio.FieldRequirement]{
+		Name:   "field requirement",
+		Values: incidentio.FieldRequirements(),
+		Parse:  incidentio.ParseFieldRequirement,
+	}
 }
 
-func (v fieldRequirementValidator) Description(ctx context.Context) string {
-	return "Field requirement must be one of 'never', 'before_closure' or 'always'"
+func isValidIncidentRoleType() enumValidator[incidentio.RoleType] {
+	return enumValidator[incidentio.RoleType]{
+		Name:   "role type",
+		Values: incidentio.RoleTypes(),
+		Parse:  incidentio.ParseRoleType,
+	}
 }
 
-func (v fieldRequirementValidator) MarkdownDescription(ctx context.Context) string {
-	return "Field requirement must be one of `never`, `before_closure` or `always`"
+func isValidIncidentStatus() enumValidator[incidentio.IncidentStatus] {
+	return enumValidator[incidentio.IncidentStatus]{
+		Name:   "incident status",
+		Values: incidentio.IncidentStatuses(),
+		Parse:  incidentio.ParseIncidentStatus,
+	}
 }
 
-func (v fieldRequirementValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-	var str types.String
-	diags := tfsdk.ValueAs(ctx, req.ConfigValue, &str)
-	resp.Diagnostics.Append(diags...)
-	if diags.HasError() {
-		return
+func isValidIncidentMode() enumValidator[incidentio.IncidentMode] {
+	return enumValidator[incidentio.IncidentMode]{
+		Name:   "incident mode",
+		Values: incidentio.IncidentModes(),
+		Parse:  incidentio.ParseIncidentMode,
 	}
+}
 
-	if str.IsUnknown() || str.IsNull() {
+func isValidIncidentVisibility() enumValidator[incidentio.IncidentVisibility] {
+	return enumValidator[incidentio.IncidentVisibility]{
+		Name:   "incident visibility",
+		Values: incidentio.IncidentVisibilities(),
+		Parse:  incidentio.ParseIncidentVisibility,
+	}
+}
+
+func isValidActionStatus() enumValidator[incidentio.ActionStatus] {
+	return enumValidator[incidentio.ActionStatus]{
+		Name:   "action status",
+		Values: incidentio.ActionStatuses(),
+		Parse:  incidentio.ParseActionStatus,
+	}
+}
+
+func isValidUserRole() enumValidator[incidentio.UserRole] {
+	return enumValidator[incidentio.UserRole]{
+		Name:   "user role",
+		Values: incidentio.UserRoles(),
+		Parse:  incidentio.ParseUserRole,
+	}
+}
+
+func isValidExternalIssueProvider() enumValidator[incidentio.ExternalIssueProvider] {
+	return enumValidator[incidentio.ExternalIssueProvider]{
+		Name:   "external issue provider",
+		Values: incidentio.ExternalIssueProviders(),
+		Parse:  incidentio.ParseExternalIssueProvider,
+	}
+}
+
+// durationValidator checks a string is a positive duration, like "30s" or "5m".
+type durationValidator struct{}
+
+func isDuration() durationValidator {
+	return durationValidator{}
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration, like 30s or 5m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration, like `30s` or `5m`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
 		return
 	}
 
-	_, err := incidentio.ParseFieldRequirement(str.ValueString())
-	if err != nil {
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
-			"Invalid field requirement",
-			"A field requirement must be one of 'never', 'before_closure' or 'always'.",
+			"Invalid Duration",
+			fmt.Sprintf("The value must be a positive duration, like 30s or 5m, got: %q.", req.ConfigValue.ValueString()),
 		)
-		return
 	}
 }
+
+// checkExactlyOneOf reports an error unless exactly one of the attributes is
+// set. Unknown values are considered as set.
+func checkExactlyOneOf(attributes map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var set []string
+	for _, name := range names {
+		if !attributes[name].IsNull() {
+			set = append(set, name)
+		}
+	}
+
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	expected := strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+
+	switch len(set) {
+	case 0:
+		diags.AddError(
+			"Missing Attribute",
+			fmt.Sprintf("Exactly one of %s must be set.", expected),
+		)
+	case 1:
+	default:
+		diags.AddAttributeError(
+			path.Root(set[1]),
+			"Conflicting Attributes",
+			fmt.Sprintf("Exactly one of %s must be set, got: %s.", expected, strings.Join(set, ", ")),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumValidator(t *testing.T) {
+	ctx := context.Background()
+	v := isValidIncidentMode()
+
+	assert.Equal(t, "incident mode must be one of 'real', 'test' or 'tutorial'", v.Description(ctx))
+	assert.Equal(t, "incident mode must be one of `real`, `test` or `tutorial`", v.MarkdownDescription(ctx))
+
+	tests := map[string]struct {
+		value    types.String
+		hasError bool
+	}{
+		"valid":   {value: types.StringValue("test"), hasError: false},
+		"invalid": {value: types.StringValue("prod"), hasError: true},
+		"null":    {value: types.StringNull(), hasError: false},
+		"unknown": {value: types.StringUnknown(), hasError: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("mode"),
+				ConfigValue: test.value,
+			}
+			resp := validator.StringResponse{}
+
+			v.ValidateString(ctx, req, &resp)
+			assert.Equal(t, test.hasError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestEnumValidatorMessages(t *testing.T) {
+	ctx := context.Background()
+
+	req := validator.StringRequest{
+		Path:        path.Root("field_type"),
+		ConfigValue: types.StringValue("number"),
+	}
+	resp := validator.StringResponse{}
+
+	isValidCustomFieldFieldType().ValidateString(ctx, req, &resp)
+
+	assert.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "Invalid field type", resp.Diagnostics[0].Summary())
+	assert.Equal(t,
+		"The field type must be one of 'single_select', 'multi_select', 'text', 'link' or 'numeric', got: \"number\".",
+		resp.Diagnostics[0].Detail())
+}
+
+func TestCheckExactlyOneOf(t *testing.T) {
+	diags := checkExactlyOneOf(map[string]attr.Value{
+		"id":   types.StringNull(),
+		"name": types.StringValue("Minor"),
+		"rank": types.Int64Null(),
+	})
+	assert.False(t, diags.HasError())
+
+	diags = checkExactlyOneOf(map[string]attr.Value{
+		"id":   types.StringUnknown(),
+		"name": types.StringNull(),
+	})
+	assert.False(t, diags.HasError())
+
+	diags = checkExactlyOneOf(map[string]attr.Value{
+		"id":   types.StringNull(),
+		"name": types.StringNull(),
+		"rank": types.Int64Null(),
+	})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Exactly one of `id`, `name` or `rank` must be set.", diags[0].Detail())
+
+	diags = checkExactlyOneOf(map[string]attr.Value{
+		"id":   types.StringNull(),
+		"name": types.StringValue("Minor"),
+		"rank": types.Int64Value(1),
+	})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Conflicting Attributes", diags[0].Summary())
+}
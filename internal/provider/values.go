@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeValue converts a timestamp returned by incident.io into an RFC3339
+// string value, or a null value if the timestamp is not set.
+func timeValue(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}